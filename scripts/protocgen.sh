@@ -0,0 +1,33 @@
+#!/usr/bin/env bash
+
+#== Requirements ==
+#
+## make sure your `go env GOPATH` is in the `$PATH`
+## Install:
+## + latest buf (v1.0.0-rc11 or later)
+## + protobuf v3
+#
+## All protoc dependencies must be installed not in the module scope
+## currently we must use grpc-gateway v1
+# cd ~
+# go install github.com/grpc-ecosystem/grpc-gateway/protoc-gen-grpc-gateway@v1.16.0
+# go get github.com/regen-network/cosmos-proto/protoc-gen-gocosmos@v0.3.1
+
+set -eo pipefail
+
+echo "Generating gogo proto code"
+cd proto
+proto_dirs=$(find ./multistaking -path -prune -o -name '*.proto' -print0 | xargs -0 -n1 dirname | sort | uniq)
+for dir in $proto_dirs; do
+  for file in $(find "${dir}" -maxdepth 1 -name '*.proto'); do
+    if grep "option go_package" $file &> /dev/null ; then
+      buf generate --template buf.gen.gogo.yaml $file
+    fi
+  done
+done
+
+cd ..
+
+# move proto files to the right places
+cp -r github.com/notional-labs/multi-staking-module/* ./
+rm -rf github.com
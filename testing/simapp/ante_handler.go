@@ -7,13 +7,17 @@ import (
 
 	ibcante "github.com/cosmos/ibc-go/v6/modules/core/ante"
 	"github.com/cosmos/ibc-go/v6/modules/core/keeper"
+
+	multistakingante "github.com/notional-labs/multi-staking-module/x/multi-staking/ante"
 )
 
-// HandlerOptions extend the SDK's AnteHandler options by requiring the IBC keeper.
+// HandlerOptions extend the SDK's AnteHandler options by requiring the IBC keeper
+// and the filter of the sdk staking messages.
 type HandlerOptions struct {
 	ante.HandlerOptions
 
-	IBCKeeper *keeper.Keeper
+	IBCKeeper        *keeper.Keeper
+	StakingMsgFilter *multistakingante.StakingMsgFilter
 }
 
 // NewAnteHandler creates a new ante handler
@@ -27,11 +31,15 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	if options.SignModeHandler == nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for AnteHandler")
 	}
+	if options.StakingMsgFilter == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "staking msg filter is required for AnteHandler")
+	}
 
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
 		ante.NewValidateBasicDecorator(),
+		multistakingante.NewStakingMsgDecorator(*options.StakingMsgFilter),
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
@@ -2,6 +2,7 @@ package simapp
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"os"
@@ -20,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
@@ -114,6 +116,13 @@ import (
 	simappupgrades "github.com/cosmos/ibc-go/v6/testing/simapp/upgrades"
 	v6 "github.com/cosmos/ibc-go/v6/testing/simapp/upgrades/v6"
 	ibctestingtypes "github.com/cosmos/ibc-go/v6/testing/types"
+
+	multistakingupgrades "github.com/notional-labs/multi-staking-module/testing/simapp/upgrades/multistaking"
+	multistaking "github.com/notional-labs/multi-staking-module/x/multi-staking"
+	multistakingante "github.com/notional-labs/multi-staking-module/x/multi-staking/ante"
+	multistakingclient "github.com/notional-labs/multi-staking-module/x/multi-staking/client"
+	multistakingkeeper "github.com/notional-labs/multi-staking-module/x/multi-staking/keeper"
+	multistakingtypes "github.com/notional-labs/multi-staking-module/x/multi-staking/types"
 )
 
 const appName = "SimApp"
@@ -146,6 +155,12 @@ var (
 				upgradeclient.LegacyCancelProposalHandler,
 				ibcclientclient.UpdateClientProposalHandler,
 				ibcclientclient.UpgradeProposalHandler,
+				multistakingclient.AddBondDenomProposalHandler,
+				multistakingclient.ChangeBondTokenWeightProposalHandler,
+				multistakingclient.RemoveBondTokenProposalHandler,
+				multistakingclient.SetStakingCapsProposalHandler,
+				multistakingclient.SetWeightBoundsProposalHandler,
+				multistakingclient.ConversionReserveSpendProposalHandler,
 			},
 		),
 		groupmodule.AppModuleBasic{},
@@ -162,6 +177,7 @@ var (
 		authzmodule.AppModuleBasic{},
 		vesting.AppModuleBasic{},
 		ibcfee.AppModuleBasic{},
+		multistaking.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -176,9 +192,14 @@ var (
 		ibcfeetypes.ModuleName:         nil,
 		icatypes.ModuleName:            nil,
 		ibcmock.ModuleName:             nil,
+		multistakingtypes.ModuleName:   {authtypes.Minter, authtypes.Burner},
 	}
 )
 
+// AllowedStakingMsgs are the type URLs of the sdk staking messages which are
+// not blocked in favor of the multi-staking module, none by default
+var AllowedStakingMsgs []string
+
 var (
 	_ App                     = (*SimApp)(nil)
 	_ servertypes.Application = (*SimApp)(nil)
@@ -221,6 +242,7 @@ type SimApp struct {
 	EvidenceKeeper      evidencekeeper.Keeper
 	TransferKeeper      ibctransferkeeper.Keeper
 	FeeGrantKeeper      feegrantkeeper.Keeper
+	MultiStakingKeeper  multistakingkeeper.Keeper
 
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper           capabilitykeeper.ScopedKeeper
@@ -275,10 +297,10 @@ func NewSimApp(
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, group.StoreKey, paramstypes.StoreKey, ibchost.StoreKey, upgradetypes.StoreKey, feegrant.StoreKey,
 		evidencetypes.StoreKey, ibctransfertypes.StoreKey, icacontrollertypes.StoreKey, icahosttypes.StoreKey, capabilitytypes.StoreKey,
-		authzkeeper.StoreKey, ibcfeetypes.StoreKey,
+		authzkeeper.StoreKey, ibcfeetypes.StoreKey, multistakingtypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
-	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
+	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey, multistakingtypes.MemStoreKey)
 
 	app := &SimApp{
 		BaseApp:           bApp,
@@ -348,6 +370,18 @@ func NewSimApp(
 		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
 	)
 
+	// NOTE: the transfer keeper is passed by reference, since it is created below
+	// and resolves the denom traces of IBC bond denoms
+	app.MultiStakingKeeper = multistakingkeeper.NewKeeper(
+		appCodec, keys[multistakingtypes.StoreKey], memKeys[multistakingtypes.MemStoreKey],
+		app.GetSubspace(multistakingtypes.ModuleName), app.AccountKeeper, app.BankKeeper, app.DistrKeeper, app.StakingKeeper,
+		app.SlashingKeeper, &app.TransferKeeper,
+	)
+
+	// the bank msg server and the transfer keeper move coins on behalf of users,
+	// so they may not send the sdkbond denom nor pay intermediary accounts
+	sendRestrictedBankKeeper := multistakingkeeper.NewSendRestrictedBankKeeper(app.BankKeeper, app.MultiStakingKeeper)
+
 	app.AuthzKeeper = authzkeeper.NewKeeper(keys[authzkeeper.StoreKey], appCodec, app.MsgServiceRouter(), app.AccountKeeper)
 
 	// IBC Keepers
@@ -362,7 +396,8 @@ func NewSimApp(
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
-		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper))
+		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
+		AddRoute(multistakingtypes.RouterKey, multistaking.NewProposalHandler(app.MultiStakingKeeper))
 
 	govConfig := govtypes.DefaultConfig()
 	/*
@@ -379,6 +414,10 @@ func NewSimApp(
 		// register the governance hooks
 		),
 	)
+	app.MultiStakingKeeper.SetGovKeeper(app.GovKeeper)
+	// NOTE: a chain with a price source for its bond denoms, such as a DEX
+	// module, sets it with app.MultiStakingKeeper.SetWeightProvider, before the
+	// module manager copies the keeper
 
 	groupConfig := group.DefaultConfig()
 	/*
@@ -404,11 +443,15 @@ func NewSimApp(
 	)
 
 	// ICA Host keeper
+	// the sdk staking messages are blocked both in the ante handler and for
+	// the interchain accounts host, which executes messages without it
+	stakingMsgFilter := multistakingante.NewStakingMsgFilter(AllowedStakingMsgs...)
+
 	app.ICAHostKeeper = icahostkeeper.NewKeeper(
 		appCodec, keys[icahosttypes.StoreKey], app.GetSubspace(icahosttypes.SubModuleName),
 		app.IBCFeeKeeper, // use ics29 fee as ics4Wrapper in middleware stack
 		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
-		app.AccountKeeper, scopedICAHostKeeper, app.MsgServiceRouter(),
+		app.AccountKeeper, scopedICAHostKeeper, multistakingante.NewStakingMsgFilterRouter(app.MsgServiceRouter(), stakingMsgFilter),
 	)
 
 	// Create IBC Router
@@ -422,7 +465,7 @@ func NewSimApp(
 		appCodec, keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
 		app.IBCFeeKeeper, // ISC4 Wrapper: fee IBC middleware
 		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
-		app.AccountKeeper, app.BankKeeper, scopedTransferKeeper,
+		app.AccountKeeper, sendRestrictedBankKeeper, scopedTransferKeeper,
 	)
 
 	// Mock Module Stack
@@ -524,7 +567,7 @@ func NewSimApp(
 		),
 		auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts),
 		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper),
-		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
+		sendRestrictedBankModule{bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper), sendRestrictedBankKeeper},
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
@@ -545,6 +588,8 @@ func NewSimApp(
 		ibcfee.NewAppModule(app.IBCFeeKeeper),
 		ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper),
 		mockModule,
+
+		multistaking.NewAppModule(appCodec, app.MultiStakingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -552,17 +597,22 @@ func NewSimApp(
 	// CanWithdrawInvariant invariant.
 	// NOTE: staking module is required if HistoricalEntries param > 0
 	// NOTE: capability module's beginblocker must come before any modules using capabilities (e.g. IBC)
+	// NOTE: The multi-staking module must occur after staking in both begin and
+	// end blockers, so that it collects the unbondings staking completes in the
+	// block and unlocks their bond tokens once staking has returned the sdkbond tokens.
 	app.mm.SetOrderBeginBlockers(
 		upgradetypes.ModuleName, capabilitytypes.ModuleName, minttypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName,
-		evidencetypes.ModuleName, stakingtypes.ModuleName, ibchost.ModuleName, ibctransfertypes.ModuleName, authtypes.ModuleName,
-		banktypes.ModuleName, govtypes.ModuleName, crisistypes.ModuleName, genutiltypes.ModuleName, authz.ModuleName, feegrant.ModuleName,
-		paramstypes.ModuleName, vestingtypes.ModuleName, icatypes.ModuleName, ibcfeetypes.ModuleName, ibcmock.ModuleName, group.ModuleName,
+		evidencetypes.ModuleName, stakingtypes.ModuleName, multistakingtypes.ModuleName, ibchost.ModuleName, ibctransfertypes.ModuleName,
+		authtypes.ModuleName, banktypes.ModuleName, govtypes.ModuleName, crisistypes.ModuleName, genutiltypes.ModuleName, authz.ModuleName,
+		feegrant.ModuleName, paramstypes.ModuleName, vestingtypes.ModuleName, icatypes.ModuleName, ibcfeetypes.ModuleName, ibcmock.ModuleName,
+		group.ModuleName,
 	)
 	app.mm.SetOrderEndBlockers(
-		crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName, ibchost.ModuleName, ibctransfertypes.ModuleName,
-		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName,
-		minttypes.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName, feegrant.ModuleName, paramstypes.ModuleName,
-		upgradetypes.ModuleName, vestingtypes.ModuleName, icatypes.ModuleName, ibcfeetypes.ModuleName, ibcmock.ModuleName, group.ModuleName,
+		crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName, multistakingtypes.ModuleName, ibchost.ModuleName,
+		ibctransfertypes.ModuleName, capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName,
+		slashingtypes.ModuleName, minttypes.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName, feegrant.ModuleName,
+		paramstypes.ModuleName, upgradetypes.ModuleName, vestingtypes.ModuleName, icatypes.ModuleName, ibcfeetypes.ModuleName, ibcmock.ModuleName,
+		group.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -570,12 +620,14 @@ func NewSimApp(
 	// NOTE: Capability module must occur first so that it can initialize any capabilities
 	// so that other modules that want to create or claim capabilities afterwards in InitChain
 	// can do so safely.
+	// the crisis module asserts the invariants of the genesis state, so it runs
+	// after the multi-staking module has set the bond denoms of the validators
 	app.mm.SetOrderInitGenesis(
 		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName, stakingtypes.ModuleName,
-		slashingtypes.ModuleName, govtypes.ModuleName, minttypes.ModuleName, crisistypes.ModuleName,
+		slashingtypes.ModuleName, govtypes.ModuleName, minttypes.ModuleName,
 		ibchost.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName, ibctransfertypes.ModuleName,
 		icatypes.ModuleName, ibcfeetypes.ModuleName, ibcmock.ModuleName, feegrant.ModuleName, paramstypes.ModuleName, upgradetypes.ModuleName,
-		vestingtypes.ModuleName, group.ModuleName,
+		vestingtypes.ModuleName, group.ModuleName, multistakingtypes.ModuleName, crisistypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
@@ -592,17 +644,18 @@ func NewSimApp(
 	// transactions
 	app.sm = module.NewSimulationManager(
 		auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts),
-		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
+		bank.NewAppModule(appCodec, sendRestrictedBankKeeper, app.AccountKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
 		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
 		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper, nil),
-		staking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
+		stakingSimulationModule{staking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper)},
+		multistaking.NewAppModule(appCodec, app.MultiStakingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		params.NewAppModule(app.ParamsKeeper),
 		evidence.NewAppModule(app.EvidenceKeeper),
-		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
+		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, sendRestrictedBankKeeper, app.interfaceRegistry),
 		ibc.NewAppModule(app.IBCKeeper),
 		transfer.NewAppModule(app.TransferKeeper),
 		ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper),
@@ -627,7 +680,8 @@ func NewSimApp(
 				FeegrantKeeper:  app.FeeGrantKeeper,
 				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 			},
-			IBCKeeper: app.IBCKeeper,
+			IBCKeeper:        app.IBCKeeper,
+			StakingMsgFilter: &stakingMsgFilter,
 		},
 	)
 	if err != nil {
@@ -639,6 +693,7 @@ func NewSimApp(
 	app.SetEndBlocker(app.EndBlocker)
 
 	app.setupUpgradeHandlers()
+	app.setupUpgradeStoreLoaders()
 
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
@@ -792,6 +847,42 @@ func (app *SimApp) SimulationManager() *module.SimulationManager {
 	return app.sm
 }
 
+// stakingSimulationModule simulates the staking module without its
+// operations. The delegations are made through the multi-staking module, whose
+// intermediary accounts the staking operations cannot sign for.
+type stakingSimulationModule struct {
+	staking.AppModule
+}
+
+// WeightedOperations returns no staking operations.
+func (stakingSimulationModule) WeightedOperations(_ module.SimulationState) []simtypes.WeightedOperation {
+	return nil
+}
+
+// sendRestrictedBankModule is the bank module whose msg server uses the
+// SendRestrictedBankKeeper of the multi-staking module, so that users cannot
+// send the sdkbond denom nor pay the intermediary accounts.
+type sendRestrictedBankModule struct {
+	bank.AppModule
+
+	keeper multistakingkeeper.SendRestrictedBankKeeper
+}
+
+// RegisterServices registers the bank services with the send restricted bank
+// keeper and the bank migrations with the wrapped bank keeper.
+func (am sendRestrictedBankModule) RegisterServices(cfg module.Configurator) {
+	banktypes.RegisterMsgServer(cfg.MsgServer(), bankkeeper.NewMsgServerImpl(am.keeper))
+	banktypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := bankkeeper.NewMigrator(am.keeper.Keeper.(bankkeeper.BaseKeeper))
+	if err := cfg.RegisterMigration(banktypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/bank from version 1 to 2: %v", err))
+	}
+	if err := cfg.RegisterMigration(banktypes.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/bank from version 2 to 3: %v", err))
+	}
+}
+
 // RegisterAPIRoutes registers all application module routes with the provided
 // API server.
 func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig) {
@@ -861,6 +952,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(ibchost.ModuleName)
 	paramsKeeper.Subspace(icacontrollertypes.SubModuleName)
 	paramsKeeper.Subspace(icahosttypes.SubModuleName)
+	paramsKeeper.Subspace(multistakingtypes.ModuleName)
 
 	return paramsKeeper
 }
@@ -887,4 +979,26 @@ func (app *SimApp) setupUpgradeHandlers() {
 			ibcmock.ModuleName+icacontrollertypes.SubModuleName,
 		),
 	)
+
+	app.UpgradeKeeper.SetUpgradeHandler(
+		multistakingupgrades.UpgradeName,
+		multistakingupgrades.CreateUpgradeHandler(app.mm, app.configurator, app.MultiStakingKeeper),
+	)
+}
+
+// setupUpgradeStoreLoaders sets the store loader adding the stores of the
+// modules introduced by the upgrade planned on disk, if any
+func (app *SimApp) setupUpgradeStoreLoaders() {
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(fmt.Sprintf("failed to read upgrade info from disk %s", err))
+	}
+
+	if app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return
+	}
+
+	if upgradeInfo.Name == multistakingupgrades.UpgradeName {
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &multistakingupgrades.StoreUpgrades))
+	}
 }
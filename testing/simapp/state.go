@@ -21,6 +21,7 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	simappparams "github.com/cosmos/ibc-go/v6/testing/simapp/params"
+	multistakingtypes "github.com/notional-labs/multi-staking-module/x/multi-staking/types"
 )
 
 // AppStateFn returns the initial application state using a genesis or the simulation parameters.
@@ -108,6 +109,42 @@ func AppStateFn(cdc codec.JSONCodec, simManager *module.SimulationManager) simty
 			panic(err)
 		}
 
+		multiStakingStateBz, ok := rawState[multistakingtypes.ModuleName]
+		if !ok {
+			panic("multi-staking genesis state is missing")
+		}
+
+		multiStakingState := new(multistakingtypes.GenesisState)
+		err = cdc.UnmarshalJSON(multiStakingStateBz, multiStakingState)
+		if err != nil {
+			panic(err)
+		}
+		// give every account as many tokens of each bond denom as of the
+		// staking bond denom, and fund the conversion reserve alike so that
+		// the redelegations between bond denoms can be simulated
+		reserveCoins := sdk.NewCoins()
+		for i, balance := range bankState.Balances {
+			amount := balance.Coins.AmountOf(stakingState.Params.BondDenom)
+			if !amount.IsPositive() {
+				continue
+			}
+			for _, weight := range multiStakingState.BondTokenWeights {
+				coin := sdk.NewCoin(weight.BondDenom, amount)
+				bankState.Balances[i].Coins = bankState.Balances[i].Coins.Add(coin)
+				bankState.Supply = bankState.Supply.Add(coin)
+				if reserveCoins.AmountOf(weight.BondDenom).IsZero() {
+					reserveCoins = reserveCoins.Add(coin)
+				}
+			}
+		}
+		if !reserveCoins.IsZero() {
+			bankState.Balances = append(bankState.Balances, banktypes.Balance{
+				Address: multistakingtypes.ConversionReserve().String(),
+				Coins:   reserveCoins,
+			})
+			bankState.Supply = bankState.Supply.Add(reserveCoins...)
+		}
+
 		bankState.Balances = append(bankState.Balances, banktypes.Balance{
 			Address: authtypes.NewModuleAddress(stakingtypes.NotBondedPoolName).String(),
 			Coins:   sdk.NewCoins(notBondedCoins),
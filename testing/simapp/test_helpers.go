@@ -30,10 +30,16 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
 	"github.com/cosmos/ibc-go/v6/testing/mock"
 	"github.com/cosmos/ibc-go/v6/testing/simapp/helpers"
+
+	multistakingtypes "github.com/notional-labs/multi-staking-module/x/multi-staking/types"
 )
 
+// GenesisBondDenom is the bond denom the genesis validators are pinned to.
+const GenesisBondDenom = "ulp"
+
 // DefaultConsensusParams defines the default Tendermint consensus params used in
 // SimApp testing.
 var DefaultConsensusParams = &abci.ConsensusParams{
@@ -63,6 +69,101 @@ func setup(withGenesis bool, invCheckPeriod uint) (*SimApp, GenesisState) {
 	return app, GenesisState{}
 }
 
+// SetupTestingApp initializes a new SimApp for the chains of the ibc-go
+// testing package. It is used by setting ibctesting.DefaultTestingAppInit.
+//
+// The ibc-go testing package builds the staking genesis of the chains itself,
+// so the app pins their genesis validators to GenesisBondDenom and turns their
+// genesis delegations into DV pairs when the chain is initialized.
+func SetupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	db := dbm.NewMemDB()
+	encCdc := MakeTestEncodingConfig()
+	app := NewSimApp(log.NewNopLogger(), db, nil, false, map[int64]bool{}, DefaultNodeHome, 0, encCdc, EmptyAppOptions{})
+	app.SetInitChainer(func(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
+		req.AppStateBytes = multiStakingTestingGenesis(app, req.AppStateBytes)
+		return app.InitChainer(ctx, req)
+	})
+	if err := app.LoadLatestVersion(); err != nil {
+		panic(err)
+	}
+	return app, NewDefaultGenesisState(encCdc.Marshaler)
+}
+
+// multiStakingTestingGenesis pins the validators of the staking genesis of an
+// ibc-go testing chain to GenesisBondDenom, and moves their delegations to the
+// intermediary accounts of DV pairs locking as many GenesisBondDenom tokens.
+func multiStakingTestingGenesis(app *SimApp, appState []byte) []byte {
+	var genesisState GenesisState
+	if err := json.Unmarshal(appState, &genesisState); err != nil {
+		panic(err)
+	}
+
+	var stakingGenesis stakingtypes.GenesisState
+	app.AppCodec().MustUnmarshalJSON(genesisState[stakingtypes.ModuleName], &stakingGenesis)
+	var bankGenesis banktypes.GenesisState
+	app.AppCodec().MustUnmarshalJSON(genesisState[banktypes.ModuleName], &bankGenesis)
+
+	validators := make(map[string]stakingtypes.Validator, len(stakingGenesis.Validators))
+	validatorBondDenoms := make([]multistakingtypes.ValidatorBondDenom, 0, len(stakingGenesis.Validators))
+	for _, validator := range stakingGenesis.Validators {
+		validators[validator.OperatorAddress] = validator
+		validatorBondDenoms = append(validatorBondDenoms, multistakingtypes.ValidatorBondDenom{
+			ValidatorAddress: validator.OperatorAddress,
+			BondDenom:        GenesisBondDenom,
+		})
+	}
+
+	multiStakingGenesis := multistakingtypes.NewGenesisState(
+		multistakingtypes.DefaultParams(),
+		[]multistakingtypes.BondTokenWeight{{BondDenom: GenesisBondDenom, BondTokenWeight: sdk.OneDec()}},
+		validatorBondDenoms,
+	)
+	multiStakingGenesis.IssuedSDKBondTokens = sdk.ZeroInt()
+
+	for i, delegation := range stakingGenesis.Delegations {
+		delAddr, valAddr := delegation.GetDelegatorAddr(), delegation.GetValidatorAddr()
+		intermediaryAccount := multistakingtypes.IntermediaryAccount(delAddr, valAddr)
+		tokens := validators[delegation.ValidatorAddress].TokensFromShares(delegation.Shares).TruncateInt()
+		bondTokens := sdk.NewCoin(GenesisBondDenom, tokens)
+
+		stakingGenesis.Delegations[i].DelegatorAddress = intermediaryAccount.String()
+		multiStakingGenesis.IntermediaryAccountDelegators = append(multiStakingGenesis.IntermediaryAccountDelegators, multistakingtypes.IntermediaryAccountDelegator{
+			IntermediaryAddress: intermediaryAccount.String(),
+			DelegatorAddress:    delegation.DelegatorAddress,
+		})
+		multiStakingGenesis.DVPairBondTokens = append(multiStakingGenesis.DVPairBondTokens, multistakingtypes.DVPairBondTokens{
+			DelegatorAddress: delegation.DelegatorAddress,
+			ValidatorAddress: delegation.ValidatorAddress,
+			BondTokens:       bondTokens,
+		})
+		multiStakingGenesis.DVPairSDKBondTokens = append(multiStakingGenesis.DVPairSDKBondTokens, multistakingtypes.DVPairSDKBondTokens{
+			DelegatorAddress: delegation.DelegatorAddress,
+			ValidatorAddress: delegation.ValidatorAddress,
+			SDKBondTokens:    sdk.NewCoin(stakingGenesis.Params.BondDenom, tokens),
+		})
+		multiStakingGenesis.IssuedSDKBondTokens = multiStakingGenesis.IssuedSDKBondTokens.Add(tokens)
+
+		// the intermediary account holds the bond tokens locked for the DV pair
+		bankGenesis.Balances = append(bankGenesis.Balances, banktypes.Balance{
+			Address: intermediaryAccount.String(),
+			Coins:   sdk.NewCoins(bondTokens),
+		})
+		if !bankGenesis.Supply.Empty() {
+			bankGenesis.Supply = bankGenesis.Supply.Add(bondTokens)
+		}
+	}
+
+	genesisState[stakingtypes.ModuleName] = app.AppCodec().MustMarshalJSON(&stakingGenesis)
+	genesisState[banktypes.ModuleName] = app.AppCodec().MustMarshalJSON(&bankGenesis)
+	genesisState[multistakingtypes.ModuleName] = app.AppCodec().MustMarshalJSON(multiStakingGenesis)
+
+	stateBytes, err := json.Marshal(genesisState)
+	if err != nil {
+		panic(err)
+	}
+	return stateBytes
+}
+
 // Setup initializes a new SimApp. A Nop logger is set in SimApp.
 func Setup(isCheckTx bool) *SimApp {
 	privVal := mock.NewPV()
@@ -126,6 +227,7 @@ func genesisStateWithValSet(app *SimApp, genesisState GenesisState,
 
 	validators := make([]stakingtypes.Validator, 0, len(valSet.Validators))
 	delegations := make([]stakingtypes.Delegation, 0, len(valSet.Validators))
+	validatorBondDenoms := make([]multistakingtypes.ValidatorBondDenom, 0, len(valSet.Validators))
 
 	bondAmt := sdk.DefaultPowerReduction
 
@@ -147,12 +249,24 @@ func genesisStateWithValSet(app *SimApp, genesisState GenesisState,
 		}
 		validators = append(validators, validator)
 		delegations = append(delegations, stakingtypes.NewDelegation(genAccs[0].GetAddress(), val.Address.Bytes(), sdk.OneDec()))
+		validatorBondDenoms = append(validatorBondDenoms, multistakingtypes.ValidatorBondDenom{
+			ValidatorAddress: validator.OperatorAddress,
+			BondDenom:        GenesisBondDenom,
+		})
 
 	}
 	// set validators and delegations
 	stakingGenesis := stakingtypes.NewGenesisState(stakingtypes.DefaultParams(), validators, delegations)
 	genesisState[stakingtypes.ModuleName] = app.AppCodec().MustMarshalJSON(stakingGenesis)
 
+	// every validator with tokens must have a bond denom
+	multiStakingGenesis := multistakingtypes.NewGenesisState(
+		multistakingtypes.DefaultParams(),
+		[]multistakingtypes.BondTokenWeight{{BondDenom: GenesisBondDenom, BondTokenWeight: sdk.OneDec()}},
+		validatorBondDenoms,
+	)
+	genesisState[multistakingtypes.ModuleName] = app.AppCodec().MustMarshalJSON(multiStakingGenesis)
+
 	totalSupply := sdk.NewCoins()
 	for _, b := range balances {
 		// add genesis acc tokens to total supply
@@ -0,0 +1,50 @@
+package multistaking
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	multistakingkeeper "github.com/notional-labs/multi-staking-module/x/multi-staking/keeper"
+	multistakingtypes "github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+const (
+	// UpgradeName defines the on-chain upgrade name for the SimApp upgrade from
+	// the plain staking module to multi-staking.
+	UpgradeName = "multi-staking"
+
+	// SDKBondDenom defines the new staking bond denom, the sdkbond denom,
+	// backing the former staking bond denom which becomes a bond denom.
+	SDKBondDenom = "sdkstake"
+)
+
+// StoreUpgrades adds the multi-staking store.
+var StoreUpgrades = storetypes.StoreUpgrades{
+	Added: []string{multistakingtypes.StoreKey},
+}
+
+// CreateUpgradeHandler creates an upgrade handler which adds the multi-staking
+// module to a chain running the plain staking module. The module migrations
+// initialize the multi-staking module with its default genesis state, then the
+// staking module is switched to the SDKBondDenom and the sdk delegations are
+// taken over by the intermediary accounts.
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	multiStakingKeeper multistakingkeeper.Keeper,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		vm, err := mm.RunMigrations(ctx, configurator, vm)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := multiStakingKeeper.MigrateStakingDelegations(ctx, SDKBondDenom); err != nil {
+			return nil, err
+		}
+
+		return vm, nil
+	}
+}
@@ -0,0 +1,202 @@
+package simapp
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	multistakingupgrades "github.com/notional-labs/multi-staking-module/testing/simapp/upgrades/multistaking"
+	multistaking "github.com/notional-labs/multi-staking-module/x/multi-staking"
+	multistakingkeeper "github.com/notional-labs/multi-staking-module/x/multi-staking/keeper"
+	multistakingtypes "github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+func TestMultiStakingUpgrade(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: app.LastBlockHeight() + 1})
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	requireInvariants := func() {
+		for _, invariant := range []sdk.Invariant{
+			multistakingkeeper.AllInvariants(app.MultiStakingKeeper),
+			stakingkeeper.AllInvariants(app.StakingKeeper),
+			distrkeeper.AllInvariants(app.DistrKeeper),
+		} {
+			msg, broken := invariant(ctx)
+			require.False(t, broken, msg)
+		}
+	}
+
+	// populate the plain staking state: the genesis self-delegation plus
+	// delegations of new accounts, one with a custom withdraw address, an
+	// unbonding delegation and a redelegation, with pending rewards
+	valAddr := app.StakingKeeper.GetAllValidators(ctx)[0].GetOperator()
+	addrs := AddTestAddrs(app, ctx, 4, sdk.NewInt(10_000_000))
+	for i, addr := range addrs[:3] {
+		validator, _ := app.StakingKeeper.GetValidator(ctx, valAddr)
+		_, err := app.StakingKeeper.Delegate(ctx, addr, sdk.NewInt(int64(1_000_000+333_333*i)), stakingtypes.Unbonded, validator, true)
+		require.NoError(t, err)
+	}
+	withdrawAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	require.NoError(t, app.DistrKeeper.SetWithdrawAddr(ctx, addrs[0], withdrawAddr))
+
+	valDstAddr := sdk.ValAddress(addrs[3])
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		valDstAddr, ed25519.GenPrivKey().PubKey(), sdk.NewInt64Coin(bondDenom, 1_000_000),
+		stakingtypes.NewDescription("moniker", "", "", "", ""),
+		stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+	)
+	require.NoError(t, err)
+	_, err = stakingkeeper.NewMsgServerImpl(app.StakingKeeper).CreateValidator(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	validator, _ := app.StakingKeeper.GetValidator(ctx, valAddr)
+	shares, err := validator.SharesFromTokens(sdk.NewInt(400_000))
+	require.NoError(t, err)
+	completionTime, err := app.StakingKeeper.Undelegate(ctx, addrs[1], valAddr, shares)
+	require.NoError(t, err)
+	_, err = app.StakingKeeper.BeginRedelegation(ctx, addrs[2], valAddr, valDstAddr, shares)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_234_567))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, rewards))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, distrtypes.ModuleName, rewards))
+	app.DistrKeeper.AllocateTokensToValidator(ctx, app.StakingKeeper.Validator(ctx, valAddr), sdk.NewDecCoinsFromCoins(rewards...))
+
+	pendingRewards := func(delAddr sdk.AccAddress) sdk.DecCoins {
+		res, err := app.DistrKeeper.DelegationRewards(sdk.WrapSDKContext(ctx), &distrtypes.QueryDelegationRewardsRequest{
+			DelegatorAddress: delAddr.String(),
+			ValidatorAddress: valAddr.String(),
+		})
+		require.NoError(t, err)
+		return res.Rewards
+	}
+
+	delegations := app.StakingKeeper.GetValidatorDelegations(ctx, valAddr)
+	require.Len(t, delegations, 4)
+	delegationRewards := make([]sdk.DecCoins, len(delegations))
+	for i, delegation := range delegations {
+		delegationRewards[i] = pendingRewards(delegation.GetDelegatorAddr())
+		require.False(t, delegationRewards[i].IsZero())
+	}
+	_, err = app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	validator, _ = app.StakingKeeper.GetValidator(ctx, valAddr)
+	lastTotalPower := app.StakingKeeper.GetLastTotalPower(ctx)
+	supply := app.BankKeeper.GetSupply(ctx, bondDenom)
+
+	// the upgrade runs from a version map without the multi-staking module, and
+	// so without the multi-staking state of the genesis validator
+	app.MultiStakingKeeper.DeleteValidatorBondDenom(ctx, valAddr)
+	app.MultiStakingKeeper.DeleteBondTokenWeight(ctx, GenesisBondDenom)
+	vm := app.mm.GetVersionMap()
+	delete(vm, multistakingtypes.ModuleName)
+	handler := multistakingupgrades.CreateUpgradeHandler(app.mm, app.configurator, app.MultiStakingKeeper)
+	_, err = handler(ctx, upgradetypes.Plan{Name: multistakingupgrades.UpgradeName}, vm)
+	require.NoError(t, err)
+
+	sdkBondDenom := app.StakingKeeper.BondDenom(ctx)
+	require.Equal(t, multistakingupgrades.SDKBondDenom, sdkBondDenom)
+	weight, found := app.MultiStakingKeeper.GetBondTokenWeight(ctx, bondDenom)
+	require.True(t, found)
+	require.Equal(t, sdk.OneDec(), weight)
+	denom, found := app.MultiStakingKeeper.GetValidatorBondDenom(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, bondDenom, denom)
+
+	// the stake is moved to the intermediary accounts rather than minted again,
+	// and the staking pools hold sdkbond tokens only
+	require.Equal(t, supply, app.BankKeeper.GetSupply(ctx, bondDenom))
+	sdkBondSupply := sdk.NewCoin(sdkBondDenom, sdk.ZeroInt())
+	for _, pool := range []string{stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName} {
+		poolAddr := app.AccountKeeper.GetModuleAddress(pool)
+		require.True(t, app.BankKeeper.GetBalance(ctx, poolAddr, bondDenom).IsZero())
+		sdkBondSupply = sdkBondSupply.Add(app.BankKeeper.GetBalance(ctx, poolAddr, sdkBondDenom))
+	}
+	require.Equal(t, sdkBondSupply, app.BankKeeper.GetSupply(ctx, sdkBondDenom))
+
+	// the voting power does not change
+	migrated, _ := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.Equal(t, validator.Tokens, migrated.Tokens)
+	require.Equal(t, validator.DelegatorShares, migrated.DelegatorShares)
+	require.Equal(t, lastTotalPower, app.StakingKeeper.GetLastTotalPower(ctx))
+	updates, err := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Empty(t, updates)
+
+	for i, delegation := range delegations {
+		delAddr := delegation.GetDelegatorAddr()
+		intermediaryAccount := multistakingtypes.IntermediaryAccount(delAddr, valAddr)
+
+		_, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+		require.False(t, found)
+		sdkDelegation, found := app.StakingKeeper.GetDelegation(ctx, intermediaryAccount, valAddr)
+		require.True(t, found)
+		require.Equal(t, delegation.Shares, sdkDelegation.Shares)
+
+		amount := validator.TokensFromShares(delegation.Shares).TruncateInt()
+		bondTokens, found := app.MultiStakingKeeper.GetDVPairBondTokens(ctx, delAddr, valAddr)
+		require.True(t, found)
+		require.Equal(t, sdk.NewCoin(bondDenom, amount), bondTokens)
+		sdkBondTokens, found := app.MultiStakingKeeper.GetDVPairSDKBondTokens(ctx, delAddr, valAddr)
+		require.True(t, found)
+		require.Equal(t, sdk.NewCoin(sdkBondDenom, amount), sdkBondTokens)
+		// the intermediary account also holds the stake of the unbonding
+		// delegation
+		locked := bondTokens
+		if delAddr.Equals(addrs[1]) {
+			locked = locked.AddAmount(sdk.NewInt(400_000))
+		}
+		require.Equal(t, locked, app.BankKeeper.GetBalance(ctx, intermediaryAccount, bondDenom))
+
+		// the pending rewards do not change and are paid to the withdraw
+		// address of the delegator
+		recipient := delAddr
+		if delAddr.Equals(addrs[0]) {
+			recipient = withdrawAddr
+		}
+		require.Equal(t, delegationRewards[i], pendingRewards(intermediaryAccount))
+		balance := app.BankKeeper.GetBalance(ctx, recipient, bondDenom)
+		withdrawn, err := app.MultiStakingKeeper.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+		require.NoError(t, err)
+		paid, _ := delegationRewards[i].TruncateDecimal()
+		require.Equal(t, paid, withdrawn)
+		require.Equal(t, balance.Add(paid[0]), app.BankKeeper.GetBalance(ctx, recipient, bondDenom))
+	}
+
+	// the unbonding delegation and the redelegation are taken over too
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, addrs[1], valAddr)
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, multistakingtypes.IntermediaryAccount(addrs[1], valAddr), valAddr)
+	require.True(t, found)
+	_, found = app.StakingKeeper.GetRedelegation(ctx, addrs[2], valAddr, valDstAddr)
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetRedelegation(ctx, multistakingtypes.IntermediaryAccount(addrs[2], valDstAddr), valAddr, valDstAddr)
+	require.True(t, found)
+	requireInvariants()
+
+	// the migrated unbonding delegation unlocks its bond tokens to the delegator
+	balance := app.BankKeeper.GetBalance(ctx, addrs[1], bondDenom)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(completionTime)
+	multistaking.BeginBlocker(ctx, app.MultiStakingKeeper)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	multistaking.EndBlocker(ctx, app.MultiStakingKeeper)
+	require.Equal(t, balance.AddAmount(sdk.NewInt(400_000)), app.BankKeeper.GetBalance(ctx, addrs[1], bondDenom))
+	_, found = app.StakingKeeper.GetRedelegation(ctx, multistakingtypes.IntermediaryAccount(addrs[2], valDstAddr), valAddr, valDstAddr)
+	require.False(t, found)
+	requireInvariants()
+
+	// the sdkbond denom cannot be migrated again
+	require.ErrorIs(t, app.MultiStakingKeeper.MigrateStakingDelegations(ctx, sdkBondDenom), multistakingtypes.ErrInvalidBondDenom)
+}
@@ -0,0 +1,40 @@
+package multistaking
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/keeper"
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// BeginBlocker collects the sdk unbonding delegations and redelegations of
+// intermediary accounts that mature in this block.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	k.CollectCompletedDelegations(ctx)
+	k.CollectCompletedRedelegations(ctx)
+}
+
+// EndBlocker unlocks the bond tokens of the sdk unbonding delegations
+// completed by the staking module in this block, reports its completed
+// redelegations, updates the dynamic bond token weights at the start of an
+// epoch, and advances the reweighting jobs and the removal of the sunsetting
+// bond denoms.
+//
+// NOTE: it must run after the staking module EndBlocker, which returns the
+// sdkbond tokens to the intermediary accounts.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	if err := k.CompleteUnbondings(ctx); err != nil {
+		panic(err)
+	}
+	k.CompleteRedelegations(ctx)
+	k.UpdateDynamicWeights(ctx)
+	k.ProcessReweightings(ctx)
+	k.ProcessSunsettingBondDenoms(ctx)
+}
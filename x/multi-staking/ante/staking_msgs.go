@@ -0,0 +1,122 @@
+package ante
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// BlockedStakingMsgs are the type URLs of the sdk staking messages which move
+// sdkbond tokens in or out of sdk delegations without the multi-staking module
+// accounting for them
+var BlockedStakingMsgs = []string{
+	sdk.MsgTypeURL(&stakingtypes.MsgCreateValidator{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgCancelUnbondingDelegation{}),
+}
+
+// StakingMsgFilter rejects the blocked sdk staking messages, including the
+// ones executed through authz, except for the allowed type URLs
+type StakingMsgFilter struct {
+	blocked map[string]bool
+}
+
+// NewStakingMsgFilter returns a StakingMsgFilter blocking the
+// BlockedStakingMsgs but the allowed type URLs
+func NewStakingMsgFilter(allowedMsgs ...string) StakingMsgFilter {
+	blocked := make(map[string]bool, len(BlockedStakingMsgs))
+	for _, typeURL := range BlockedStakingMsgs {
+		blocked[typeURL] = true
+	}
+	for _, typeURL := range allowedMsgs {
+		delete(blocked, typeURL)
+	}
+	return StakingMsgFilter{blocked: blocked}
+}
+
+// Validate returns an error if any of the messages, or of the messages they
+// execute through authz, is a blocked sdk staking message
+func (f StakingMsgFilter) Validate(msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		if typeURL := sdk.MsgTypeURL(msg); f.blocked[typeURL] {
+			return sdkerrors.Wrapf(types.ErrStakingMsgNotAllowed, "%s, use the multi-staking module instead", typeURL)
+		}
+
+		if execMsg, ok := msg.(*authz.MsgExec); ok {
+			execMsgs, err := execMsg.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := f.Validate(execMsgs); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// StakingMsgDecorator rejects the transactions with blocked sdk staking
+// messages
+type StakingMsgDecorator struct {
+	filter StakingMsgFilter
+}
+
+// NewStakingMsgDecorator returns a StakingMsgDecorator with the given filter
+func NewStakingMsgDecorator(filter StakingMsgFilter) StakingMsgDecorator {
+	return StakingMsgDecorator{filter: filter}
+}
+
+// AnteHandle rejects the transaction if it has a blocked sdk staking message.
+// The genesis transactions are not filtered, as they create the genesis
+// validators with the sdk staking MsgCreateValidator.
+func (d StakingMsgDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	if err := d.filter.Validate(tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
+
+// MsgRouter is the message router of the modules executing messages outside of
+// transactions, such as the interchain accounts host
+type MsgRouter interface {
+	Handler(msg sdk.Msg) baseapp.MsgServiceHandler
+}
+
+// StakingMsgFilterRouter wraps a MsgRouter to reject the blocked sdk staking
+// messages, which the ante handler never sees when executed by another module
+type StakingMsgFilterRouter struct {
+	router MsgRouter
+	filter StakingMsgFilter
+}
+
+// NewStakingMsgFilterRouter returns a StakingMsgFilterRouter wrapping the
+// router with the given filter
+func NewStakingMsgFilterRouter(router MsgRouter, filter StakingMsgFilter) StakingMsgFilterRouter {
+	return StakingMsgFilterRouter{router: router, filter: filter}
+}
+
+// Handler returns the handler of the wrapped router, rejecting the blocked sdk
+// staking messages
+func (r StakingMsgFilterRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	handler := r.router.Handler(msg)
+	if handler == nil {
+		return nil
+	}
+
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		if err := r.filter.Validate([]sdk.Msg{msg}); err != nil {
+			return nil, err
+		}
+		return handler(ctx, msg)
+	}
+}
@@ -0,0 +1,84 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/notional-labs/multi-staking-module/testing/simapp"
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/ante"
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+func TestStakingMsgDecorator(t *testing.T) {
+	_, _, delAddr := testdata.KeyTestPubAddr()
+	_, _, addr := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(addr)
+	coin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+
+	delegate := stakingtypes.NewMsgDelegate(delAddr, valAddr, coin)
+	send := banktypes.NewMsgSend(delAddr, addr, sdk.NewCoins(coin))
+	exec := func(msgs ...sdk.Msg) sdk.Msg {
+		msg := authz.NewMsgExec(addr, msgs)
+		return &msg
+	}
+
+	testCases := []struct {
+		name       string
+		msgs       []sdk.Msg
+		allowed    []string
+		expBlocked bool
+	}{
+		{"bank send", []sdk.Msg{send}, nil, false},
+		{"delegate", []sdk.Msg{send, delegate}, nil, true},
+		{"undelegate", []sdk.Msg{stakingtypes.NewMsgUndelegate(delAddr, valAddr, coin)}, nil, true},
+		{"redelegate", []sdk.Msg{stakingtypes.NewMsgBeginRedelegate(delAddr, valAddr, valAddr, coin)}, nil, true},
+		{"cancel unbonding", []sdk.Msg{stakingtypes.NewMsgCancelUnbondingDelegation(delAddr, valAddr, 1, coin)}, nil, true},
+		{"edit validator", []sdk.Msg{stakingtypes.NewMsgEditValidator(valAddr, stakingtypes.Description{}, nil, nil)}, nil, false},
+		{"delegate in authz exec", []sdk.Msg{exec(send, delegate)}, nil, true},
+		{"delegate in nested authz exec", []sdk.Msg{exec(exec(delegate))}, nil, true},
+		{"allowed delegate", []sdk.Msg{exec(delegate)}, []string{sdk.MsgTypeURL(delegate)}, false},
+	}
+
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: app.LastBlockHeight() + 1})
+	txConfig := simapp.MakeTestEncodingConfig().TxConfig
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filter := ante.NewStakingMsgFilter(tc.allowed...)
+
+			txBuilder := txConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(tc.msgs...))
+			decorator := ante.NewStakingMsgDecorator(filter)
+			_, err := decorator.AnteHandle(ctx, txBuilder.GetTx(), false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+				return ctx, nil
+			})
+
+			// the interchain accounts host executes each message on its own
+			router := ante.NewStakingMsgFilterRouter(app.MsgServiceRouter(), filter)
+			var routerErr error
+			for _, msg := range tc.msgs {
+				handler := router.Handler(msg)
+				require.NotNil(t, handler)
+				if _, err := handler(ctx, msg); types.ErrStakingMsgNotAllowed.Is(err) {
+					routerErr = err
+				}
+			}
+
+			if tc.expBlocked {
+				require.ErrorIs(t, err, types.ErrStakingMsgNotAllowed)
+				require.ErrorIs(t, routerErr, types.ErrStakingMsgNotAllowed)
+			} else {
+				require.NoError(t, err)
+				require.NoError(t, routerErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,273 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// NewCmdSubmitAddBondDenomProposal implements a command handler for submitting an add bond denom proposal transaction.
+func NewCmdSubmitAddBondDenomProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-bond-denom [denom] [weight] [flags]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to add a bond denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to accept a token as a bond token with the given weight, along with an initial deposit.
+An IBC denom must have been received over IBC, and may be pinned to the channel it was received over.
+
+Example:
+$ %s tx gov submit-legacy-proposal add-bond-denom ulp 0.5 --title="Add ulp" --description="Accept ulp as bond token" --deposit=10000000stake --from mykey
+$ %s tx gov submit-legacy-proposal add-bond-denom ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2 0.5 --source-channel=channel-0 --title="Add atom" --description="Accept atom as bond token" --deposit=10000000stake --from mykey
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			weight, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			sourceChannel, err := cmd.Flags().GetString(FlagSourceChannel)
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewAddBondDenomProposal(title, description, args[0], weight, sourceChannel)
+			})
+		},
+	}
+
+	cmd.Flags().String(FlagSourceChannel, "", "The channel an IBC bond denom must have been received over")
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// NewCmdSubmitChangeBondTokenWeightProposal implements a command handler for submitting a change bond token weight proposal transaction.
+func NewCmdSubmitChangeBondTokenWeightProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-bond-token-weight [denom] [weight] [flags]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to change the weight of a bond token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to change the weight of a bond token, along with an initial deposit.
+
+Example:
+$ %s tx gov submit-legacy-proposal change-bond-token-weight ulp 0.3 --title="Change ulp weight" --description="Lower the ulp weight" --deposit=10000000stake --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			weight, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewChangeBondTokenWeightProposal(title, description, args[0], weight)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// NewCmdSubmitRemoveBondTokenProposal implements a command handler for submitting a remove bond token proposal transaction.
+func NewCmdSubmitRemoveBondTokenProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-bond-token [denom] [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to remove a bond token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to stop accepting a token as a bond token, along with an initial deposit.
+The proposal fails to execute if the token still has delegations.
+
+Example:
+$ %s tx gov submit-legacy-proposal remove-bond-token ulp --title="Remove ulp" --description="Stop accepting ulp" --deposit=10000000stake --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewRemoveBondTokenProposal(title, description, args[0])
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// NewCmdSubmitSetStakingCapsProposal implements a command handler for submitting a set staking caps proposal transaction.
+func NewCmdSubmitSetStakingCapsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-staking-caps [denom] [max-bonded-tokens] [max-power-share] [flags]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit a proposal to set the staking caps of a bond token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to cap the bond tokens of a bond token delegated at once, and the share of the
+tokens of all validators held by the validators pinned to it, along with an initial deposit. A zero cap is not enforced.
+
+Example:
+$ %s tx gov submit-legacy-proposal set-staking-caps ulp 1000000000000 0.2 --title="Cap ulp" --description="Cap the ulp staking" --deposit=10000000stake --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			maxBondedTokens, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid max bonded tokens: %s", args[1])
+			}
+
+			maxPowerShare, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewSetStakingCapsProposal(title, description, args[0], types.NewStakingCaps(maxBondedTokens, maxPowerShare))
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// NewCmdSubmitSetWeightBoundsProposal implements a command handler for submitting a set weight bounds proposal transaction.
+func NewCmdSubmitSetWeightBoundsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-weight-bounds [denom] [min-weight] [max-weight] [max-change-rate] [flags]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Submit a proposal to set the weight bounds of a bond token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to recompute the weight of a bond token every epoch from the weight provider of the
+chain, within a min and max weight and changing by at most a rate of the weight per epoch, along with an initial
+deposit. A zero max change rate is not enforced, and zero bounds make the weight static again.
+
+Example:
+$ %s tx gov submit-legacy-proposal set-weight-bounds ulp 0.1 2 0.05 --title="Dynamic ulp weight" --description="Follow the ulp price" --deposit=10000000stake --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bounds := make([]sdk.Dec, 3)
+			for i, arg := range args[1:] {
+				bound, err := sdk.NewDecFromStr(arg)
+				if err != nil {
+					return err
+				}
+				bounds[i] = bound
+			}
+
+			return submitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewSetWeightBoundsProposal(title, description, args[0], types.NewWeightBounds(bounds[0], bounds[1], bounds[2]))
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// NewCmdSubmitConversionReserveSpendProposal implements a command handler for submitting a conversion reserve spend proposal transaction.
+func NewCmdSubmitConversionReserveSpendProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "conversion-reserve-spend [recipient] [amount] [flags]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to spend bond tokens of the conversion reserve",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to send bond tokens of the conversion reserve to a recipient, e.g. to rebalance the
+reserve between bond denoms, along with an initial deposit.
+
+Example:
+$ %s tx gov submit-legacy-proposal conversion-reserve-spend cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq 1000000ulp --title="Rebalance" --description="Swap ulp for uatom" --deposit=10000000stake --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewConversionReserveSpendProposal(title, description, recipient, amount)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// submitProposal reads the common proposal flags, builds the proposal content
+// and generates or broadcasts the MsgSubmitProposal transaction.
+func submitProposal(cmd *cobra.Command, newContent func(title, description string) govtypes.Content) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	title, err := cmd.Flags().GetString(govcli.FlagTitle) //nolint:staticcheck
+	if err != nil {
+		return err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription) //nolint:staticcheck
+	if err != nil {
+		return err
+	}
+
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	content := newContent(title, description)
+	if err := content.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")             //nolint:staticcheck
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal") //nolint:staticcheck
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.MarkFlagRequired(govcli.FlagTitle)       //nolint:staticcheck
+	cmd.MarkFlagRequired(govcli.FlagDescription) //nolint:staticcheck
+}
@@ -0,0 +1,483 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// GetQueryCmd returns the cli query commands for the multi-staking module.
+func GetQueryCmd() *cobra.Command {
+	multiStakingQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the multi-staking module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	multiStakingQueryCmd.AddCommand(
+		GetCmdQueryIntermediaryAccount(),
+		GetCmdQueryIntermediaryAccountDelegator(),
+		GetCmdQueryBondTokenWeights(),
+		GetCmdQueryBondTokenWeight(),
+		GetCmdQueryStakingHeadrooms(),
+		GetCmdQueryStakingHeadroom(),
+		GetCmdQueryValidatorBondDenom(),
+		GetCmdQueryDelegation(),
+		GetCmdQueryDelegations(),
+		GetCmdQueryUnbondings(),
+		GetCmdQueryConversionReserve(),
+	)
+
+	return multiStakingQueryCmd
+}
+
+// GetCmdQueryIntermediaryAccount implements the intermediary account query command.
+func GetCmdQueryIntermediaryAccount() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "intermediary-account [delegator-addr] [validator-addr]",
+		Short: "Query the intermediary account of a delegation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the intermediary account holding the sdk delegation of a delegator to a validator.
+
+Example:
+$ %s query multistaking intermediary-account %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixAccAddr, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IntermediaryAccount(cmd.Context(), &types.QueryIntermediaryAccountRequest{
+				DelegatorAddress: args[0],
+				ValidatorAddress: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryIntermediaryAccountDelegator implements the intermediary account delegator query command.
+func GetCmdQueryIntermediaryAccountDelegator() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "intermediary-account-delegator [intermediary-addr]",
+		Short: "Query the delegator of an intermediary account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the delegator an intermediary account, the delegator of an sdk delegation, acts for.
+
+Example:
+$ %s query multistaking intermediary-account-delegator %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IntermediaryAccountDelegator(cmd.Context(), &types.QueryIntermediaryAccountDelegatorRequest{
+				IntermediaryAddress: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryBondTokenWeights implements the bond token weights query command.
+func GetCmdQueryBondTokenWeights() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bond-token-weights",
+		Short: "Query the accepted bond denoms and their weights",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BondTokenWeights(cmd.Context(), &types.QueryBondTokenWeightsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "bond token weights")
+
+	return cmd
+}
+
+// GetCmdQueryBondTokenWeight implements the bond token weight query command.
+func GetCmdQueryBondTokenWeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bond-token-weight [bond-denom]",
+		Short: "Query the weight of a bond denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the weight of a bond denom, and whether it is being removed.
+
+Example:
+$ %s query multistaking bond-token-weight ulp
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BondTokenWeight(cmd.Context(), &types.QueryBondTokenWeightRequest{
+				BondDenom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryStakingHeadrooms implements the staking headrooms query command.
+func GetCmdQueryStakingHeadrooms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "staking-headrooms",
+		Short: "Query the staking caps of the bond denoms and the bond tokens that can still be delegated",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.StakingHeadrooms(cmd.Context(), &types.QueryStakingHeadroomsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "staking headrooms")
+
+	return cmd
+}
+
+// GetCmdQueryStakingHeadroom implements the staking headroom query command.
+func GetCmdQueryStakingHeadroom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "staking-headroom [bond-denom]",
+		Short: "Query the staking caps of a bond denom and the bond tokens that can still be delegated",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the staking caps of a bond denom, its bonded tokens and power share, and the bond tokens
+that can still be delegated within the caps.
+
+Example:
+$ %s query multistaking staking-headroom ulp
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.StakingHeadroom(cmd.Context(), &types.QueryStakingHeadroomRequest{
+				BondDenom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryValidatorBondDenom implements the validator bond denom query command.
+func GetCmdQueryValidatorBondDenom() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "validator-bond-denom [validator-addr]",
+		Short: "Query the bond denom of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the bond denom a validator can be delegated with.
+
+Example:
+$ %s query multistaking validator-bond-denom %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ValidatorBondDenom(cmd.Context(), &types.QueryValidatorBondDenomRequest{
+				ValidatorAddress: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryDelegation implements the multi-staking delegation query command.
+func GetCmdQueryDelegation() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "delegation [delegator-addr] [validator-addr]",
+		Short: "Query a multi-staking delegation based on address and validator address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the bond tokens a delegator delegated to a validator, along with the sdk delegation backing them.
+
+Example:
+$ %s query multistaking delegation %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixAccAddr, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MultiStakingDelegation(cmd.Context(), &types.QueryMultiStakingDelegationRequest{
+				DelegatorAddress: args[0],
+				ValidatorAddress: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Delegation)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryDelegations implements the delegator multi-staking delegations query command.
+func GetCmdQueryDelegations() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "delegations [delegator-addr]",
+		Short: "Query all multi-staking delegations made by one delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the multi-staking delegations of a delegator to all validators.
+
+Example:
+$ %s query multistaking delegations %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegatorMultiStakingDelegations(cmd.Context(), &types.QueryDelegatorMultiStakingDelegationsRequest{
+				DelegatorAddress: args[0],
+				Pagination:       pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "delegations")
+
+	return cmd
+}
+
+// GetCmdQueryUnbondings implements the multi-staking unbondings query command.
+func GetCmdQueryUnbondings() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "unbondings [delegator-addr]",
+		Short: "Query all multi-staking unbondings of one delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the multi-staking unbondings of a delegator from all validators.
+
+Example:
+$ %s query multistaking unbondings %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.MultiStakingUnbondings(cmd.Context(), &types.QueryMultiStakingUnbondingsRequest{
+				DelegatorAddress: args[0],
+				Pagination:       pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "unbondings")
+
+	return cmd
+}
+
+// GetCmdQueryConversionReserve implements the conversion reserve query command.
+func GetCmdQueryConversionReserve() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "conversion-reserve",
+		Short: "Query the address and the balance of the conversion reserve",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the address of the conversion reserve and the bond tokens it holds to pay converting redelegations.
+
+Example:
+$ %s query multistaking conversion-reserve
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ConversionReserve(cmd.Context(), &types.QueryConversionReserveRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
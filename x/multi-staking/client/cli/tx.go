@@ -0,0 +1,736 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	authzcli "github.com/cosmos/cosmos-sdk/x/authz/client/cli"
+	govutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingcli "github.com/cosmos/cosmos-sdk/x/staking/client/cli"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/multi-staking transaction commands.
+func NewTxCmd() *cobra.Command {
+	multiStakingTxCmd := &cobra.Command{
+		Use:                        "multi-staking",
+		Short:                      "Multi-staking transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	multiStakingTxCmd.AddCommand(
+		NewCreateValidatorCmd(),
+		NewDelegateCmd(),
+		NewRedelegateCmd(),
+		NewUnbondCmd(),
+		NewCancelUnbondingDelegationCmd(),
+		NewWithdrawRewardsCmd(),
+		NewVoteCmd(),
+		NewWeightedVoteCmd(),
+		NewUnjailCmd(),
+		NewFundConversionReserveCmd(),
+		NewGrantAuthorizationCmd(),
+	)
+
+	return multiStakingTxCmd
+}
+
+// NewCreateValidatorCmd returns a CLI command handler for creating a MsgCreateValidator transaction.
+func NewCreateValidatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-validator",
+		Short: "create new validator pinned to a bond denom and initialized with a self-delegation to it",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a new validator whose delegations can only be made in the given bond denom.
+The self-delegation amount must be denominated in the bond denom.
+
+Example:
+$ %s tx multi-staking create-validator --bond-denom=ulp --amount=1000000ulp --pubkey=<pubkey> --moniker=myvalidator \
+  --commission-rate=0.1 --commission-max-rate=0.2 --commission-max-change-rate=0.01 --min-self-delegation=1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).
+				WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+			txf, msg, err := newBuildCreateValidatorMsg(clientCtx, txf, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(stakingcli.FlagSetPublicKey())
+	cmd.Flags().AddFlagSet(stakingcli.FlagSetAmount())
+	cmd.Flags().AddFlagSet(FlagSetBondDenom())
+	cmd.Flags().AddFlagSet(flagSetDescriptionCreate())
+	cmd.Flags().AddFlagSet(stakingcli.FlagSetCommissionCreate())
+	cmd.Flags().AddFlagSet(stakingcli.FlagSetMinSelfDelegation())
+
+	cmd.Flags().String(stakingcli.FlagIP, "", fmt.Sprintf("The node's public IP. It takes effect only when used in combination with --%s", flags.FlagGenerateOnly))
+	cmd.Flags().String(stakingcli.FlagNodeID, "", "The node's ID")
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+	_ = cmd.MarkFlagRequired(stakingcli.FlagAmount)
+	_ = cmd.MarkFlagRequired(FlagBondDenom)
+	_ = cmd.MarkFlagRequired(stakingcli.FlagPubKey)
+	_ = cmd.MarkFlagRequired(stakingcli.FlagMoniker)
+
+	return cmd
+}
+
+// NewDelegateCmd returns a CLI command handler for creating a MsgDelegate transaction.
+func NewDelegateCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "delegate [validator-addr] [amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Delegate bond tokens to a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Delegate an amount of bond tokens to a validator from your wallet.
+The denom of the bond tokens must be the bond denom of the validator.
+
+Example:
+$ %s tx multi-staking delegate %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm 1000ulp --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDelegate(delAddr, valAddr, amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRedelegateCmd returns a CLI command handler for creating a MsgBeginRedelegate transaction.
+func NewRedelegateCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "redelegate [src-validator-addr] [dst-validator-addr] [amount]",
+		Short: "Redelegate bond tokens from one validator to another",
+		Args:  cobra.ExactArgs(3),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Redelegate an amount of bonded bond tokens from one validator to another.
+Validators of different bond denoms only accept redelegations with --%s, which
+converts the bond tokens at the ratio of the bond token weights.
+
+Example:
+$ %s tx multi-staking redelegate %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm 100ulp --from mykey
+`,
+				FlagConvert, version.AppName, bech32PrefixValAddr, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			valSrcAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			valDstAddr, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			convert, err := cmd.Flags().GetBool(FlagConvert)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBeginRedelegate(delAddr, valSrcAddr, valDstAddr, amount, convert)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagConvert, false, "Convert the bond tokens to the bond denom of the destination validator")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewUnbondCmd returns a CLI command handler for creating a MsgUndelegate transaction.
+func NewUnbondCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "unbond [validator-addr] [amount]",
+		Short: "Unbond bond tokens from a validator",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Unbond an amount of bonded bond tokens from a validator. The bond tokens
+are returned to your wallet once the unbonding period has passed.
+
+Example:
+$ %s tx multi-staking unbond %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100ulp --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUndelegate(delAddr, valAddr, amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCancelUnbondingDelegationCmd returns a CLI command handler for creating a MsgCancelUnbondingDelegation transaction.
+func NewCancelUnbondingDelegationCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "cancel-unbond [validator-addr] [amount] [creation-height]",
+		Short: "Cancel unbonding delegation and delegate back to the validator",
+		Args:  cobra.ExactArgs(3),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel an amount of unbonding bond tokens and delegate them back to the validator.
+The unbonding is identified by the creation height of its unbonding delegation entry.
+
+Example:
+$ %s tx multi-staking cancel-unbond %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100ulp 2 --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			creationHeight, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "invalid creation height %s", args[2])
+			}
+
+			msg := types.NewMsgCancelUnbondingDelegation(delAddr, valAddr, creationHeight, amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewWithdrawRewardsCmd returns a CLI command handler for creating a MsgWithdrawDelegatorReward transaction.
+func NewWithdrawRewardsCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "withdraw-rewards [validator-addr]",
+		Short: "Withdraw the rewards of a delegation to a validator",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw the rewards earned by your delegation to a validator. The rewards
+are sent to your wallet.
+
+Example:
+$ %s tx multi-staking withdraw-rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawDelegatorReward(delAddr, valAddr)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewUnjailCmd returns a CLI command handler for creating a MsgUnjail transaction.
+func NewUnjailCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unjail",
+		Short: "Unjail a jailed validator",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Unjail a validator previously jailed for downtime or for unbonding below its
+min self delegation. The validator operator signs the transaction.
+
+Example:
+$ %s tx multi-staking unjail --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnjail(sdk.ValAddress(clientCtx.GetFromAddress()))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewFundConversionReserveCmd returns a CLI command handler for creating a MsgFundConversionReserve transaction.
+func NewFundConversionReserveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-conversion-reserve [amount]",
+		Short: "Deposit bond tokens into the conversion reserve",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Deposit bond tokens into the conversion reserve, which pays the bond tokens of
+redelegations converting between bond denoms.
+
+Example:
+$ %s tx multi-staking fund-conversion-reserve 1000000ulp,1000000uatom --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFundConversionReserve(clientCtx.GetFromAddress(), amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewVoteCmd returns a CLI command handler for creating a MsgVote transaction.
+func NewVoteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote [proposal-id] [option]",
+		Short: "Vote for an active proposal with your delegations, options: yes/no/no_with_veto/abstain",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a vote for an active proposal with all your multi-staking delegations.
+You can find the proposal-id by running "%s query gov proposals".
+
+Example:
+$ %s tx multi-staking vote 1 yes --from mykey
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			voter := clientCtx.GetFromAddress()
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			option, err := govv1.VoteOptionFromString(govutils.NormalizeVoteOption(args[1]))
+			if err != nil {
+				return err
+			}
+
+			metadata, err := cmd.Flags().GetString(FlagMetadata)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgVote(voter, proposalID, option, metadata)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMetadata, "", "Specify metadata of the vote")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewWeightedVoteCmd returns a CLI command handler for creating a MsgVoteWeighted transaction.
+func NewWeightedVoteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "weighted-vote [proposal-id] [weighted-options]",
+		Short: "Vote for an active proposal with your delegations, options: yes/no/no_with_veto/abstain",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a weighted vote for an active proposal with all your multi-staking
+delegations. You can find the proposal-id by running "%s query gov proposals".
+
+Example:
+$ %s tx multi-staking weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05 --from mykey
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			voter := clientCtx.GetFromAddress()
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			options, err := govv1.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(args[1]))
+			if err != nil {
+				return err
+			}
+
+			metadata, err := cmd.Flags().GetString(FlagMetadata)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgVoteWeighted(voter, proposalID, options, metadata)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMetadata, "", "Specify metadata of the vote")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewGrantAuthorizationCmd returns a CLI command handler for creating a MsgGrant transaction with a
+// MultiStakeAuthorization.
+func NewGrantAuthorizationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee] [delegate|unbond|redelegate]",
+		Short: "Grant an address the right to delegate, undelegate or redelegate your bond tokens",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant an address the right to execute multi-staking delegations, undelegations or
+redelegations on your behalf. The grant is limited to the allowed validators, or to any validator
+but the denied ones. With a spend limit, only the bond denoms of the spend limit can be used, up
+to their amount. Redelegations may only convert the bond tokens with --%[3]s.
+
+Example:
+$ %[1]s tx multi-staking grant cosmos1skjw.. delegate --spend-limit=1000000ulp --allowed-validators=%[2]s1l2rs.. --from mykey
+`,
+				version.AppName, sdk.GetConfig().GetBech32ValidatorAddrPrefix(), FlagAllowConvert,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var authzType stakingtypes.AuthorizationType
+			switch args[1] {
+			case "delegate":
+				authzType = stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE
+			case "unbond":
+				authzType = stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_UNDELEGATE
+			case "redelegate":
+				authzType = stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE
+			default:
+				return fmt.Errorf("invalid authorization type, %s", args[1])
+			}
+
+			limit, err := cmd.Flags().GetString(authzcli.FlagSpendLimit)
+			if err != nil {
+				return err
+			}
+			var maxTokens sdk.Coins
+			if limit != "" {
+				maxTokens, err = sdk.ParseCoinsNormalized(limit)
+				if err != nil {
+					return err
+				}
+				if !maxTokens.IsAllPositive() {
+					return fmt.Errorf("spend-limit should be greater than zero")
+				}
+			}
+
+			allowed, err := validatorAddressesFlag(cmd.Flags(), authzcli.FlagAllowedValidators)
+			if err != nil {
+				return err
+			}
+			denied, err := validatorAddressesFlag(cmd.Flags(), authzcli.FlagDenyValidators)
+			if err != nil {
+				return err
+			}
+
+			allowConvert, err := cmd.Flags().GetBool(FlagAllowConvert)
+			if err != nil {
+				return err
+			}
+
+			authorization, err := types.NewMultiStakeAuthorization(allowed, denied, authzType, maxTokens, allowConvert)
+			if err != nil {
+				return err
+			}
+
+			var expiration *time.Time
+			exp, err := cmd.Flags().GetInt64(authzcli.FlagExpiration)
+			if err != nil {
+				return err
+			}
+			if exp != 0 {
+				e := time.Unix(exp, 0)
+				expiration = &e
+			}
+
+			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(authzcli.FlagSpendLimit, "", "The bond tokens the grantee can use, one amount per bond denom")
+	cmd.Flags().StringSlice(authzcli.FlagAllowedValidators, []string{}, "Allowed validators addresses separated by ,")
+	cmd.Flags().StringSlice(authzcli.FlagDenyValidators, []string{}, "Deny validators addresses separated by ,")
+	cmd.Flags().Bool(FlagAllowConvert, false, "Allow the redelegations converting the bond tokens")
+	cmd.Flags().Int64(authzcli.FlagExpiration, 0, "Expire time as Unix timestamp. Set zero (0) for no expiry. Default is 0.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func validatorAddressesFlag(fs *flag.FlagSet, name string) ([]sdk.ValAddress, error) {
+	validators, err := fs.GetStringSlice(name)
+	if err != nil {
+		return nil, err
+	}
+
+	valAddrs := make([]sdk.ValAddress, len(validators))
+	for i, validator := range validators {
+		valAddrs[i], err = sdk.ValAddressFromBech32(validator)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return valAddrs, nil
+}
+
+func newBuildCreateValidatorMsg(clientCtx client.Context, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, *types.MsgCreateValidator, error) {
+	fAmount, _ := fs.GetString(stakingcli.FlagAmount)
+	amount, err := sdk.ParseCoinNormalized(fAmount)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	bondDenom, _ := fs.GetString(FlagBondDenom)
+
+	valAddr := clientCtx.GetFromAddress()
+	pkStr, err := fs.GetString(stakingcli.FlagPubKey)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	var pk cryptotypes.PubKey
+	if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(pkStr), &pk); err != nil {
+		return txf, nil, err
+	}
+
+	moniker, _ := fs.GetString(stakingcli.FlagMoniker)
+	identity, _ := fs.GetString(stakingcli.FlagIdentity)
+	website, _ := fs.GetString(stakingcli.FlagWebsite)
+	security, _ := fs.GetString(stakingcli.FlagSecurityContact)
+	details, _ := fs.GetString(stakingcli.FlagDetails)
+	description := stakingtypes.NewDescription(
+		moniker,
+		identity,
+		website,
+		security,
+		details,
+	)
+
+	// get the initial validator commission parameters
+	rateStr, _ := fs.GetString(stakingcli.FlagCommissionRate)
+	maxRateStr, _ := fs.GetString(stakingcli.FlagCommissionMaxRate)
+	maxChangeRateStr, _ := fs.GetString(stakingcli.FlagCommissionMaxChangeRate)
+
+	commissionRates, err := buildCommissionRates(rateStr, maxRateStr, maxChangeRateStr)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	// get the initial validator min self delegation
+	msbStr, _ := fs.GetString(stakingcli.FlagMinSelfDelegation)
+
+	minSelfDelegation, ok := sdk.NewIntFromString(msbStr)
+	if !ok {
+		return txf, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "minimum self delegation must be a positive integer")
+	}
+
+	msg, err := types.NewMsgCreateValidator(
+		sdk.ValAddress(valAddr), pk, amount, description, commissionRates, minSelfDelegation, bondDenom,
+	)
+	if err != nil {
+		return txf, nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return txf, nil, err
+	}
+
+	genOnly, _ := fs.GetBool(flags.FlagGenerateOnly)
+	if genOnly {
+		ip, _ := fs.GetString(stakingcli.FlagIP)
+		nodeID, _ := fs.GetString(stakingcli.FlagNodeID)
+
+		if nodeID != "" && ip != "" {
+			txf = txf.WithMemo(fmt.Sprintf("%s@%s:26656", nodeID, ip))
+		}
+	}
+
+	return txf, msg, nil
+}
+
+func buildCommissionRates(rateStr, maxRateStr, maxChangeRateStr string) (commission stakingtypes.CommissionRates, err error) {
+	if rateStr == "" || maxRateStr == "" || maxChangeRateStr == "" {
+		return commission, errors.New("must specify all validator commission parameters")
+	}
+
+	rate, err := sdk.NewDecFromStr(rateStr)
+	if err != nil {
+		return commission, err
+	}
+
+	maxRate, err := sdk.NewDecFromStr(maxRateStr)
+	if err != nil {
+		return commission, err
+	}
+
+	maxChangeRate, err := sdk.NewDecFromStr(maxChangeRateStr)
+	if err != nil {
+		return commission, err
+	}
+
+	commission = stakingtypes.NewCommissionRates(rate, maxRate, maxChangeRate)
+
+	return commission, nil
+}
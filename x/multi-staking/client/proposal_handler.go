@@ -0,0 +1,17 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/client/cli"
+)
+
+// Proposal handlers of the bond denom proposals.
+var (
+	AddBondDenomProposalHandler           = govclient.NewProposalHandler(cli.NewCmdSubmitAddBondDenomProposal)
+	ChangeBondTokenWeightProposalHandler  = govclient.NewProposalHandler(cli.NewCmdSubmitChangeBondTokenWeightProposal)
+	RemoveBondTokenProposalHandler        = govclient.NewProposalHandler(cli.NewCmdSubmitRemoveBondTokenProposal)
+	SetStakingCapsProposalHandler         = govclient.NewProposalHandler(cli.NewCmdSubmitSetStakingCapsProposal)
+	SetWeightBoundsProposalHandler        = govclient.NewProposalHandler(cli.NewCmdSubmitSetWeightBoundsProposal)
+	ConversionReserveSpendProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitConversionReserveSpendProposal)
+)
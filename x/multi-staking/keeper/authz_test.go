@@ -0,0 +1,224 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/notional-labs/multi-staking-module/testing/simapp"
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+const otherBondDenom = "uatom"
+
+func (suite *KeeperTestSuite) TestMultiStakeAuthorization() {
+	delegateType := stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE
+	redelegateType := stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE
+
+	testCases := []struct {
+		name      string
+		grant     func(valAddr, otherValAddr sdk.ValAddress) (*types.MultiStakeAuthorization, error)
+		msgs      func(granter sdk.AccAddress, valAddr, otherValAddr sdk.ValAddress) []sdk.Msg
+		expectErr error
+		// expLimit is the limit left once the msgs are executed, nil if the
+		// grant is deleted
+		expLimit sdk.Coins
+	}{
+		{
+			name: "delegate within the limit",
+			grant: func(valAddr, _ sdk.ValAddress) (*types.MultiStakeAuthorization, error) {
+				return types.NewMultiStakeAuthorization([]sdk.ValAddress{valAddr}, nil, delegateType, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000)), false)
+			},
+			msgs: func(granter sdk.AccAddress, valAddr, _ sdk.ValAddress) []sdk.Msg {
+				return []sdk.Msg{types.NewMsgDelegate(granter, valAddr, sdk.NewInt64Coin(bondDenom, 400))}
+			},
+			expLimit: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 600)),
+		},
+		{
+			name: "delegate the whole limit",
+			grant: func(valAddr, _ sdk.ValAddress) (*types.MultiStakeAuthorization, error) {
+				return types.NewMultiStakeAuthorization([]sdk.ValAddress{valAddr}, nil, delegateType, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000)), false)
+			},
+			msgs: func(granter sdk.AccAddress, valAddr, _ sdk.ValAddress) []sdk.Msg {
+				return []sdk.Msg{
+					types.NewMsgDelegate(granter, valAddr, sdk.NewInt64Coin(bondDenom, 400)),
+					types.NewMsgDelegate(granter, valAddr, sdk.NewInt64Coin(bondDenom, 600)),
+				}
+			},
+		},
+		{
+			name: "delegate the limit of one of the bond denoms",
+			grant: func(_, _ sdk.ValAddress) (*types.MultiStakeAuthorization, error) {
+				return types.NewMultiStakeAuthorization(nil, []sdk.ValAddress{sdk.ValAddress("denied")}, delegateType, sdk.NewCoins(
+					sdk.NewInt64Coin(bondDenom, 1000), sdk.NewInt64Coin(otherBondDenom, 500),
+				), false)
+			},
+			msgs: func(granter sdk.AccAddress, _, otherValAddr sdk.ValAddress) []sdk.Msg {
+				return []sdk.Msg{types.NewMsgDelegate(granter, otherValAddr, sdk.NewInt64Coin(otherBondDenom, 500))}
+			},
+			expLimit: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000)),
+		},
+		{
+			name: "delegate more than the limit",
+			grant: func(valAddr, _ sdk.ValAddress) (*types.MultiStakeAuthorization, error) {
+				return types.NewMultiStakeAuthorization([]sdk.ValAddress{valAddr}, nil, delegateType, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000)), false)
+			},
+			msgs: func(granter sdk.AccAddress, valAddr, _ sdk.ValAddress) []sdk.Msg {
+				return []sdk.Msg{types.NewMsgDelegate(granter, valAddr, sdk.NewInt64Coin(bondDenom, 1001))}
+			},
+			expectErr: sdkerrors.ErrInsufficientFunds,
+		},
+		{
+			name: "delegate a bond denom without limit",
+			grant: func(valAddr, otherValAddr sdk.ValAddress) (*types.MultiStakeAuthorization, error) {
+				return types.NewMultiStakeAuthorization([]sdk.ValAddress{valAddr, otherValAddr}, nil, delegateType, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000)), false)
+			},
+			msgs: func(granter sdk.AccAddress, _, otherValAddr sdk.ValAddress) []sdk.Msg {
+				return []sdk.Msg{types.NewMsgDelegate(granter, otherValAddr, sdk.NewInt64Coin(otherBondDenom, 100))}
+			},
+			expectErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name: "delegate any bond denom without max tokens",
+			grant: func(valAddr, otherValAddr sdk.ValAddress) (*types.MultiStakeAuthorization, error) {
+				return types.NewMultiStakeAuthorization([]sdk.ValAddress{valAddr, otherValAddr}, nil, delegateType, nil, false)
+			},
+			msgs: func(granter sdk.AccAddress, valAddr, otherValAddr sdk.ValAddress) []sdk.Msg {
+				return []sdk.Msg{
+					types.NewMsgDelegate(granter, valAddr, sdk.NewInt64Coin(bondDenom, 5000)),
+					types.NewMsgDelegate(granter, otherValAddr, sdk.NewInt64Coin(otherBondDenom, 5000)),
+				}
+			},
+			expLimit: sdk.Coins{},
+		},
+		{
+			name: "delegate to a validator not allowed",
+			grant: func(valAddr, _ sdk.ValAddress) (*types.MultiStakeAuthorization, error) {
+				return types.NewMultiStakeAuthorization([]sdk.ValAddress{valAddr}, nil, delegateType, nil, false)
+			},
+			msgs: func(granter sdk.AccAddress, _, otherValAddr sdk.ValAddress) []sdk.Msg {
+				return []sdk.Msg{types.NewMsgDelegate(granter, otherValAddr, sdk.NewInt64Coin(otherBondDenom, 100))}
+			},
+			expectErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name: "delegate to a denied validator",
+			grant: func(_, otherValAddr sdk.ValAddress) (*types.MultiStakeAuthorization, error) {
+				return types.NewMultiStakeAuthorization(nil, []sdk.ValAddress{otherValAddr}, delegateType, nil, false)
+			},
+			msgs: func(granter sdk.AccAddress, _, otherValAddr sdk.ValAddress) []sdk.Msg {
+				return []sdk.Msg{types.NewMsgDelegate(granter, otherValAddr, sdk.NewInt64Coin(otherBondDenom, 100))}
+			},
+			expectErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name: "redelegate to an allowed validator",
+			grant: func(valAddr, _ sdk.ValAddress) (*types.MultiStakeAuthorization, error) {
+				return types.NewMultiStakeAuthorization([]sdk.ValAddress{valAddr}, nil, redelegateType, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000)), false)
+			},
+			msgs: func(granter sdk.AccAddress, valAddr, _ sdk.ValAddress) []sdk.Msg {
+				sameDenomValAddr := suite.createValidator(sdk.NewInt64Coin(bondDenom, 1000))
+				_, err := suite.msgServer.Delegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgDelegate(granter, sameDenomValAddr, sdk.NewInt64Coin(bondDenom, 1000)))
+				suite.Require().NoError(err)
+				return []sdk.Msg{types.NewMsgBeginRedelegate(granter, sameDenomValAddr, valAddr, sdk.NewInt64Coin(bondDenom, 300), false)}
+			},
+			expLimit: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 700)),
+		},
+		{
+			name: "redelegate to a validator not allowed",
+			grant: func(valAddr, _ sdk.ValAddress) (*types.MultiStakeAuthorization, error) {
+				return types.NewMultiStakeAuthorization([]sdk.ValAddress{valAddr}, nil, redelegateType, nil, false)
+			},
+			msgs: func(granter sdk.AccAddress, valAddr, _ sdk.ValAddress) []sdk.Msg {
+				sameDenomValAddr := suite.createValidator(sdk.NewInt64Coin(bondDenom, 1000))
+				_, err := suite.msgServer.Delegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgDelegate(granter, valAddr, sdk.NewInt64Coin(bondDenom, 1000)))
+				suite.Require().NoError(err)
+				return []sdk.Msg{types.NewMsgBeginRedelegate(granter, valAddr, sameDenomValAddr, sdk.NewInt64Coin(bondDenom, 300), false)}
+			},
+			expectErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name: "convert without allow convert",
+			grant: func(_, otherValAddr sdk.ValAddress) (*types.MultiStakeAuthorization, error) {
+				return types.NewMultiStakeAuthorization([]sdk.ValAddress{otherValAddr}, nil, redelegateType, nil, false)
+			},
+			msgs: func(granter sdk.AccAddress, valAddr, otherValAddr sdk.ValAddress) []sdk.Msg {
+				_, err := suite.msgServer.Delegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgDelegate(granter, valAddr, sdk.NewInt64Coin(bondDenom, 1000)))
+				suite.Require().NoError(err)
+				return []sdk.Msg{types.NewMsgBeginRedelegate(granter, valAddr, otherValAddr, sdk.NewInt64Coin(bondDenom, 400), true)}
+			},
+			expectErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name: "convert with allow convert",
+			grant: func(_, otherValAddr sdk.ValAddress) (*types.MultiStakeAuthorization, error) {
+				return types.NewMultiStakeAuthorization([]sdk.ValAddress{otherValAddr}, nil, redelegateType, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000)), true)
+			},
+			msgs: func(granter sdk.AccAddress, valAddr, otherValAddr sdk.ValAddress) []sdk.Msg {
+				_, err := suite.msgServer.Delegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgDelegate(granter, valAddr, sdk.NewInt64Coin(bondDenom, 1000)))
+				suite.Require().NoError(err)
+				reserve := sdk.NewCoins(sdk.NewInt64Coin(otherBondDenom, 1000))
+				suite.Require().NoError(simapp.FundAccount(suite.app, suite.ctx, types.ConversionReserve(), reserve))
+				return []sdk.Msg{types.NewMsgBeginRedelegate(granter, valAddr, otherValAddr, sdk.NewInt64Coin(bondDenom, 400), true)}
+			},
+			expLimit: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 600)),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.msKeeper.SetBondTokenWeight(suite.ctx, otherBondDenom, sdk.OneDec())
+			valAddr := suite.validator.GetOperator()
+			otherValAddr := suite.createValidator(sdk.NewInt64Coin(otherBondDenom, 1000))
+
+			granter := suite.fundedAccount(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10000), sdk.NewInt64Coin(otherBondDenom, 10000)))
+			_, _, grantee := testdata.KeyTestPubAddr()
+
+			authorization, err := tc.grant(valAddr, otherValAddr)
+			suite.Require().NoError(err)
+			suite.Require().NoError(authorization.ValidateBasic())
+			suite.Require().NoError(suite.app.AuthzKeeper.SaveGrant(suite.ctx, grantee, granter, authorization, nil))
+
+			exec := authz.NewMsgExec(grantee, tc.msgs(granter, valAddr, otherValAddr))
+			_, err = suite.app.MsgServiceRouter().Handler(&exec)(suite.ctx, &exec)
+			if tc.expectErr != nil {
+				suite.Require().ErrorIs(err, tc.expectErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			updated, _ := suite.app.AuthzKeeper.GetAuthorization(suite.ctx, grantee, granter, authorization.MsgTypeURL())
+			if tc.expLimit == nil {
+				suite.Require().Nil(updated)
+				return
+			}
+			suite.Require().NotNil(updated)
+			suite.Require().True(tc.expLimit.IsEqual(updated.(*types.MultiStakeAuthorization).MaxTokens))
+			suite.Require().Equal(authorization.AllowConvert, updated.(*types.MultiStakeAuthorization).AllowConvert)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMultiStakeAuthorizationValidateBasic() {
+	valAddr := suite.validator.GetOperator()
+
+	authorization, err := types.NewMultiStakeAuthorization(nil, nil, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, nil, false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	suite.Require().Nil(authorization)
+
+	authorization, err = types.NewMultiStakeAuthorization([]sdk.ValAddress{valAddr}, []sdk.ValAddress{valAddr}, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, nil, false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	suite.Require().Nil(authorization)
+
+	authorization, err = types.NewMultiStakeAuthorization([]sdk.ValAddress{valAddr}, nil, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_UNSPECIFIED, nil, false)
+	suite.Require().NoError(err)
+	suite.Require().ErrorIs(authorization.ValidateBasic(), authz.ErrUnknownAuthorizationType)
+
+	authorization, err = types.NewMultiStakeAuthorization([]sdk.ValAddress{valAddr}, nil, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_UNDELEGATE, sdk.Coins{sdk.NewInt64Coin(bondDenom, 0)}, false)
+	suite.Require().NoError(err)
+	suite.Require().ErrorIs(authorization.ValidateBasic(), sdkerrors.ErrInvalidCoins)
+	suite.Require().Equal(sdk.MsgTypeURL(&types.MsgUndelegate{}), authorization.MsgTypeURL())
+}
@@ -0,0 +1,205 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// CancelUnbondingDelegation delegates an amount of unbonding bond tokens back
+// to the validator. The sdk unbonding delegation entry is found by its
+// creation height, and the bond tokens are taken from the unbonding tokens
+// record of its completion time.
+//
+// The sdkbond tokens delegated back are pro-rata to the balance of the sdk
+// unbonding delegation entries of the record, so that a cancel after a
+// slashing of the entries carries the slashing over to the delegation. If the
+// entries complete in this block, the sdkbond tokens are removed from the
+// completed delegations collected in BeginBlock too.
+func (k Keeper) CancelUnbondingDelegation(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, creationHeight int64, amount sdk.Coin,
+) error {
+	if err := k.validateValidatorBondDenom(ctx, valAddr, amount.Denom); err != nil {
+		return err
+	}
+	if k.IsBondDenomSunsetting(ctx, amount.Denom) {
+		return sdkerrors.Wrapf(types.ErrBondDenomSunsetting, "%s", amount.Denom)
+	}
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return stakingtypes.ErrNoValidatorFound
+	}
+	if validator.InvalidExRate() {
+		return stakingtypes.ErrDelegatorShareExRateInvalid
+	}
+	if validator.IsJailed() {
+		return stakingtypes.ErrValidatorJailed
+	}
+
+	intermediaryAccount := types.IntermediaryAccount(delAddr, valAddr)
+	ubd, found := k.stakingKeeper.GetUnbondingDelegation(ctx, intermediaryAccount, valAddr)
+	if !found {
+		return sdkerrors.Wrapf(stakingtypes.ErrNoUnbondingDelegation, "delegator %s validator %s", delAddr, valAddr)
+	}
+
+	entryIndex := -1
+	for i, entry := range ubd.Entries {
+		if entry.CreationHeight == creationHeight {
+			entryIndex = i
+			break
+		}
+	}
+	if entryIndex == -1 {
+		return sdkerrors.ErrNotFound.Wrapf("unbonding delegation entry is not found at block height %d", creationHeight)
+	}
+
+	completionTime := ubd.Entries[entryIndex].CompletionTime
+	if completionTime.Before(ctx.BlockTime()) {
+		return sdkerrors.ErrInvalidRequest.Wrap("unbonding delegation is already processed")
+	}
+
+	tokens, found := k.GetDVPairUnbondingTokens(ctx, delAddr, valAddr, completionTime)
+	if !found || tokens.BondTokens.Denom != amount.Denom {
+		return sdkerrors.Wrapf(
+			stakingtypes.ErrNoUnbondingDelegation, "delegator %s validator %s completion time %s", delAddr, valAddr, completionTime,
+		)
+	}
+	if amount.Amount.GT(tokens.BondTokens.Amount) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("amount %s is greater than the unbonding %s", amount, tokens.BondTokens)
+	}
+
+	// the sdkbond tokens minted for the cancelled bond tokens, and the balance
+	// of the entries left for them after slashing
+	balance := math.ZeroInt()
+	for _, entry := range ubd.Entries {
+		if entry.CompletionTime.Equal(completionTime) {
+			balance = balance.Add(entry.Balance)
+		}
+	}
+	sdkBondAmount, delegateAmount := tokens.SDKBondTokens.Amount, balance
+	if amount.Amount.LT(tokens.BondTokens.Amount) {
+		sdkBondAmount = sdkBondAmount.Mul(amount.Amount).Quo(tokens.BondTokens.Amount)
+		delegateAmount = balance.Mul(sdkBondAmount).Quo(tokens.SDKBondTokens.Amount)
+	}
+	if !delegateAmount.IsPositive() {
+		return sdkerrors.ErrInvalidRequest.Wrapf("amount %s is too small", amount)
+	}
+	if err := k.validateStakingCaps(ctx, amount, delegateAmount); err != nil {
+		return err
+	}
+
+	if _, found := k.stakingKeeper.GetDelegation(ctx, intermediaryAccount, valAddr); found {
+		if _, err := k.withdrawRewards(ctx, delAddr, valAddr); err != nil {
+			return err
+		}
+	}
+
+	if _, err := k.stakingKeeper.Delegate(ctx, intermediaryAccount, delegateAmount, stakingtypes.Unbonding, validator, false); err != nil {
+		return err
+	}
+
+	k.subUnbondingEntriesBalance(ctx, ubd, entryIndex, delegateAmount)
+	if !completionTime.After(ctx.BlockTime()) {
+		k.subCompletedDelegation(ctx, delAddr, valAddr, completionTime, delegateAmount)
+	}
+
+	tokens.BondTokens = tokens.BondTokens.Sub(amount)
+	tokens.SDKBondTokens = tokens.SDKBondTokens.SubAmount(sdkBondAmount)
+	if tokens.BondTokens.IsZero() && tokens.SDKBondTokens.IsZero() {
+		k.DeleteDVPairUnbondingTokens(ctx, delAddr, valAddr, completionTime)
+	} else {
+		k.SetDVPairUnbondingTokens(ctx, delAddr, valAddr, completionTime, tokens)
+	}
+
+	sdkBondTokens := sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), sdkBondAmount)
+	k.addDVPairTokens(ctx, delAddr, valAddr, amount, sdkBondTokens)
+
+	// the sdkbond tokens of the DV pair may differ from its bond tokens times
+	// the weight by the rounding, or by a weight change during the unbonding
+	weight, _ := k.GetBondTokenWeight(ctx, amount.Denom)
+	bondTokens, _ := k.GetDVPairBondTokens(ctx, delAddr, valAddr)
+	if _, _, err := k.syncDVPairSDKBondTokens(ctx, delAddr, valAddr, bondTokens, weight); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// subUnbondingEntriesBalance subtracts an amount from the balance of the sdk
+// unbonding delegation entries completing with the given entry, starting with
+// it, and removes the entries left empty.
+func (k Keeper) subUnbondingEntriesBalance(ctx sdk.Context, ubd stakingtypes.UnbondingDelegation, entryIndex int, amount math.Int) {
+	completionTime := ubd.Entries[entryIndex].CompletionTime
+
+	indexes := []int{entryIndex}
+	for i, entry := range ubd.Entries {
+		if i != entryIndex && entry.CompletionTime.Equal(completionTime) {
+			indexes = append(indexes, i)
+		}
+	}
+
+	removed := make(map[int]bool)
+	for _, i := range indexes {
+		if amount.IsZero() {
+			break
+		}
+		entry := ubd.Entries[i]
+		sub := math.MinInt(amount, entry.Balance)
+		entry.Balance = entry.Balance.Sub(sub)
+		entry.InitialBalance = entry.InitialBalance.Sub(sub)
+		ubd.Entries[i] = entry
+		amount = amount.Sub(sub)
+		removed[i] = entry.Balance.IsZero()
+	}
+
+	entries := ubd.Entries[:0]
+	for i, entry := range ubd.Entries {
+		if !removed[i] {
+			entries = append(entries, entry)
+		}
+	}
+	ubd.Entries = entries
+
+	if len(ubd.Entries) == 0 {
+		k.stakingKeeper.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		k.stakingKeeper.SetUnbondingDelegation(ctx, ubd)
+	}
+}
+
+// subCompletedDelegation subtracts an amount of sdkbond tokens from the
+// completed delegation of a DV pair collected in BeginBlock, removing it once
+// it is empty. The delegation is only emptied by cancelling all the bond tokens
+// of its unbonding tokens record, which is removed along with it.
+func (k Keeper) subCompletedDelegation(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, completionTime time.Time, amount math.Int,
+) {
+	completed := k.GetCompletedDelegations(ctx)
+	for i, delegation := range completed {
+		if delegation.DelegatorAddress != delAddr.String() ||
+			delegation.ValidatorAddress != valAddr.String() ||
+			!delegation.CompletionTime.Equal(completionTime) {
+			continue
+		}
+
+		delegation.Amount = delegation.Amount.SubAmount(math.MinInt(amount, delegation.Amount.Amount))
+		if delegation.Amount.IsZero() {
+			completed = append(completed[:i], completed[i+1:]...)
+		} else {
+			completed[i] = delegation
+		}
+		break
+	}
+
+	if len(completed) > 0 {
+		k.SetCompletedDelegations(ctx, completed)
+	} else {
+		k.DeleteCompletedDelegations(ctx)
+	}
+}
@@ -0,0 +1,187 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// requireDVPairTokens checks the bond tokens and sdkbond tokens records of a DV pair
+func (suite *KeeperTestSuite) requireDVPairTokens(delAddr sdk.AccAddress, valAddr sdk.ValAddress, bondAmount, sdkBondAmount int64) {
+	bondTokens, _ := suite.msKeeper.GetDVPairBondTokens(suite.ctx, delAddr, valAddr)
+	suite.Require().Equal(sdk.NewInt64Coin(bondDenom, bondAmount), bondTokens)
+	sdkBondTokens, _ := suite.msKeeper.GetDVPairSDKBondTokens(suite.ctx, delAddr, valAddr)
+	suite.Require().Equal(sdk.NewInt64Coin(suite.app.StakingKeeper.BondDenom(suite.ctx), sdkBondAmount), sdkBondTokens)
+}
+
+func (suite *KeeperTestSuite) TestCancelUnbondingDelegation() {
+	testCases := []struct {
+		name             string
+		cancelled        int64
+		expBondTokens    int64
+		expSDKBondTokens int64
+		expUnlocked      int64
+	}{
+		{
+			name:             "full cancel",
+			cancelled:        600,
+			expBondTokens:    1000,
+			expSDKBondTokens: 500,
+		},
+		{
+			name:             "partial cancel",
+			cancelled:        201,
+			expBondTokens:    601,
+			expSDKBondTokens: 300,
+			expUnlocked:      399,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.disableInflation()
+			valAddr := suite.validator.GetOperator()
+			delAddr := suite.delegateAll(valAddr, 1000)[0]
+			intermediaryAccount := types.IntermediaryAccount(delAddr, valAddr)
+
+			undelegated := sdk.NewInt64Coin(bondDenom, 600)
+			res, err := suite.msgServer.Undelegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgUndelegate(delAddr, valAddr, undelegated))
+			suite.Require().NoError(err)
+			creationHeight := suite.ctx.BlockHeight()
+			suite.nextBlock(suite.ctx.BlockTime())
+
+			cancelled := sdk.NewInt64Coin(bondDenom, tc.cancelled)
+			_, err = suite.msgServer.CancelUnbondingDelegation(
+				sdk.WrapSDKContext(suite.ctx), types.NewMsgCancelUnbondingDelegation(delAddr, valAddr, creationHeight, cancelled),
+			)
+			suite.Require().NoError(err)
+			suite.requireInvariant()
+			suite.requireDVPairTokens(delAddr, valAddr, tc.expBondTokens, tc.expSDKBondTokens)
+
+			tokens, found := suite.msKeeper.GetDVPairUnbondingTokens(suite.ctx, delAddr, valAddr, res.CompletionTime)
+			_, ubdFound := suite.app.StakingKeeper.GetUnbondingDelegation(suite.ctx, intermediaryAccount, valAddr)
+			if tc.expUnlocked == 0 {
+				suite.Require().False(found)
+				suite.Require().False(ubdFound)
+			} else {
+				suite.Require().True(found)
+				suite.Require().Equal(undelegated.Sub(cancelled), tokens.BondTokens)
+				suite.Require().True(ubdFound)
+			}
+
+			// the cancelled bond tokens cannot be cancelled again
+			_, err = suite.msgServer.CancelUnbondingDelegation(
+				sdk.WrapSDKContext(suite.ctx), types.NewMsgCancelUnbondingDelegation(delAddr, valAddr, creationHeight, undelegated),
+			)
+			suite.Require().Error(err)
+
+			suite.nextBlock(res.CompletionTime)
+			suite.Require().Equal(sdk.NewInt64Coin(bondDenom, tc.expUnlocked), suite.app.BankKeeper.GetBalance(suite.ctx, delAddr, bondDenom))
+			suite.Require().Equal(sdk.NewInt64Coin(bondDenom, tc.expBondTokens), suite.app.BankKeeper.GetBalance(suite.ctx, intermediaryAccount, bondDenom))
+			suite.requireInvariant()
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCancelUnbondingAfterSlashing() {
+	suite.disableInflation()
+	suite.nextBlock(suite.ctx.BlockTime())
+	valAddr := suite.validator.GetOperator()
+	delAddr := suite.delegateAll(valAddr, 1000)[0]
+	intermediaryAccount := types.IntermediaryAccount(delAddr, valAddr)
+
+	undelegated := sdk.NewInt64Coin(bondDenom, 1000)
+	res, err := suite.msgServer.Undelegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgUndelegate(delAddr, valAddr, undelegated))
+	suite.Require().NoError(err)
+	creationHeight := suite.ctx.BlockHeight()
+
+	// slash the validator by 5% for an infraction at the height of the
+	// undelegation, so the 500stake unbonding entry is slashed to 475stake
+	suite.nextBlock(suite.ctx.BlockTime())
+	consAddr, err := suite.validator.GetConsAddr()
+	suite.Require().NoError(err)
+	validator, _ := suite.app.StakingKeeper.GetValidator(suite.ctx, valAddr)
+	power := validator.GetConsensusPower(suite.app.StakingKeeper.PowerReduction(suite.ctx))
+	suite.app.SlashingKeeper.Slash(suite.ctx, consAddr, sdk.NewDecWithPrec(5, 2), power, creationHeight)
+	ubd, _ := suite.app.StakingKeeper.GetUnbondingDelegation(suite.ctx, intermediaryAccount, valAddr)
+	suite.Require().Equal(sdk.NewInt(475), ubd.Entries[0].Balance)
+
+	// cancelling 400ulp moves the 200stake minted for them back to the DV
+	// pair, but only delegates the 190stake left of them after slashing
+	_, err = suite.msgServer.CancelUnbondingDelegation(
+		sdk.WrapSDKContext(suite.ctx), types.NewMsgCancelUnbondingDelegation(delAddr, valAddr, creationHeight, sdk.NewInt64Coin(bondDenom, 400)),
+	)
+	suite.Require().NoError(err)
+	suite.requireInvariant()
+	suite.requireDVPairTokens(delAddr, valAddr, 400, 200)
+
+	validator, _ = suite.app.StakingKeeper.GetValidator(suite.ctx, valAddr)
+	delegation, _ := suite.app.StakingKeeper.GetDelegation(suite.ctx, intermediaryAccount, valAddr)
+	suite.Require().Equal(sdk.NewInt(190), validator.TokensFromShares(delegation.Shares).RoundInt())
+	ubd, _ = suite.app.StakingKeeper.GetUnbondingDelegation(suite.ctx, intermediaryAccount, valAddr)
+	suite.Require().Equal(sdk.NewInt(285), ubd.Entries[0].Balance)
+
+	// the remaining 600ulp are unlocked pro-rata to the 285stake returned
+	suite.nextBlock(res.CompletionTime)
+	suite.Require().Equal(sdk.NewInt64Coin(bondDenom, 570), suite.app.BankKeeper.GetBalance(suite.ctx, delAddr, bondDenom))
+	suite.Require().Equal(sdk.NewInt64Coin(bondDenom, 400), suite.app.BankKeeper.GetBalance(suite.ctx, intermediaryAccount, bondDenom))
+	suite.requireInvariant()
+}
+
+func (suite *KeeperTestSuite) TestCancelUnbondingRacingCompletion() {
+	testCases := []struct {
+		name        string
+		cancelled   int64
+		expUnlocked int64
+	}{
+		{
+			name:      "full cancel",
+			cancelled: 600,
+		},
+		{
+			name:        "partial cancel",
+			cancelled:   201,
+			expUnlocked: 399,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.disableInflation()
+			valAddr := suite.validator.GetOperator()
+			delAddr := suite.delegateAll(valAddr, 1000)[0]
+
+			undelegated := sdk.NewInt64Coin(bondDenom, 600)
+			res, err := suite.msgServer.Undelegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgUndelegate(delAddr, valAddr, undelegated))
+			suite.Require().NoError(err)
+			creationHeight := suite.ctx.BlockHeight()
+
+			// the cancel is delivered in the block completing the unbonding,
+			// after BeginBlock collected it
+			suite.ctx = suite.ctx.
+				WithBlockHeight(suite.ctx.BlockHeight() + 1).
+				WithBlockTime(res.CompletionTime)
+			suite.app.BeginBlocker(suite.ctx, abci.RequestBeginBlock{})
+			suite.Require().Len(suite.msKeeper.GetCompletedDelegations(suite.ctx), 1)
+
+			cancelled := sdk.NewInt64Coin(bondDenom, tc.cancelled)
+			_, err = suite.msgServer.CancelUnbondingDelegation(
+				sdk.WrapSDKContext(suite.ctx), types.NewMsgCancelUnbondingDelegation(delAddr, valAddr, creationHeight, cancelled),
+			)
+			suite.Require().NoError(err)
+			suite.Require().NotPanics(func() {
+				suite.app.EndBlocker(suite.ctx, abci.RequestEndBlock{})
+			})
+
+			suite.Require().Empty(suite.msKeeper.GetCompletedDelegations(suite.ctx))
+			suite.Require().Equal(sdk.NewInt64Coin(bondDenom, tc.expUnlocked), suite.app.BankKeeper.GetBalance(suite.ctx, delAddr, bondDenom))
+			suite.requireDVPairTokens(delAddr, valAddr, 400+tc.cancelled, (400+tc.cancelled)/2)
+			_, found := suite.msKeeper.GetDVPairUnbondingTokens(suite.ctx, delAddr, valAddr, res.CompletionTime)
+			suite.Require().False(found)
+			suite.requireInvariant()
+		})
+	}
+}
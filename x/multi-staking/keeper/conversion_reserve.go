@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// GetConversionReserveBalance returns the bond tokens held by the conversion
+// reserve.
+func (k Keeper) GetConversionReserveBalance(ctx sdk.Context) sdk.Coins {
+	return k.bankKeeper.GetAllBalances(ctx, types.ConversionReserve())
+}
+
+// FundConversionReserve deposits bond tokens of the depositor into the
+// conversion reserve. Only bond denoms may be deposited, since the reserve
+// pays nothing else.
+func (k Keeper) FundConversionReserve(ctx sdk.Context, depositor sdk.AccAddress, amount sdk.Coins) error {
+	for _, coin := range amount {
+		if !k.IsBondDenom(ctx, coin.Denom) {
+			return sdkerrors.Wrapf(types.ErrInvalidBondDenom, "%s", coin.Denom)
+		}
+	}
+
+	return k.bankKeeper.SendCoins(ctx, depositor, types.ConversionReserve(), amount)
+}
+
+// payFromConversionReserve sends bond tokens of the conversion reserve to the
+// recipient, failing with ErrInsufficientConversionReserve if the reserve
+// holds too few of them.
+func (k Keeper) payFromConversionReserve(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coins) error {
+	reserve := types.ConversionReserve()
+	if balance := k.bankKeeper.GetAllBalances(ctx, reserve); !balance.IsAllGTE(amount) {
+		return sdkerrors.Wrapf(types.ErrInsufficientConversionReserve, "reserve holds %s, needs %s", balance, amount)
+	}
+
+	return k.bankKeeper.SendCoins(ctx, reserve, recipient, amount)
+}
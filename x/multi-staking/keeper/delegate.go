@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// Delegate locks the bond tokens of a delegator in the intermediary account of
+// the (delegator, validator) pair, mints the sdkbond tokens backed by them and
+// delegates those to the validator on behalf of the delegator.
+func (k Keeper) Delegate(ctx sdk.Context, delAddr sdk.AccAddress, validator stakingtypes.Validator, amount sdk.Coin) (sdk.Dec, error) {
+	valAddr := validator.GetOperator()
+	if err := k.validateValidatorBondDenom(ctx, valAddr, amount.Denom); err != nil {
+		return sdk.Dec{}, err
+	}
+
+	// the rewards of an existing sdk delegation are forwarded before its
+	// shares change
+	if _, found := k.stakingKeeper.GetDelegation(ctx, types.IntermediaryAccount(delAddr, valAddr), valAddr); found {
+		if _, err := k.withdrawRewards(ctx, delAddr, valAddr); err != nil {
+			return sdk.Dec{}, err
+		}
+	}
+
+	intermediaryAccount, sdkBondAmount, err := k.lockAndMint(ctx, delAddr, valAddr, amount)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	// the sdkbond tokens are already in the intermediary account, which is
+	// never a module account, so the sdk delegation always starts unbonded
+	return k.stakingKeeper.Delegate(ctx, intermediaryAccount, sdkBondAmount, stakingtypes.Unbonded, validator, true)
+}
+
+// validateValidatorBondDenom checks that the denom is an accepted bond token
+// and that the validator is pinned to it
+func (k Keeper) validateValidatorBondDenom(ctx sdk.Context, valAddr sdk.ValAddress, denom string) error {
+	if !k.IsBondDenom(ctx, denom) {
+		return sdkerrors.Wrapf(types.ErrInvalidBondDenom, "%s", denom)
+	}
+
+	bondDenom, found := k.GetValidatorBondDenom(ctx, valAddr)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrValidatorBondDenomNotFound, "validator %s", valAddr)
+	}
+	if bondDenom != denom {
+		return sdkerrors.Wrapf(types.ErrMismatchedValidatorBondDenom, "got %s, expected %s", denom, bondDenom)
+	}
+
+	return nil
+}
+
+// lockAndMint moves the bond tokens of a delegator to the intermediary account
+// of the (delegator, validator) pair, mints the corresponding sdkbond tokens to
+// it and updates the DV pair records. It returns the intermediary account and
+// the minted sdkbond amount.
+func (k Keeper) lockAndMint(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin,
+) (sdk.AccAddress, math.Int, error) {
+	weight, found := k.GetBondTokenWeight(ctx, amount.Denom)
+	if !found {
+		return nil, math.Int{}, sdkerrors.Wrapf(types.ErrInvalidBondDenom, "%s", amount.Denom)
+	}
+	if k.IsBondDenomSunsetting(ctx, amount.Denom) {
+		return nil, math.Int{}, sdkerrors.Wrapf(types.ErrBondDenomSunsetting, "%s", amount.Denom)
+	}
+
+	// the sdkbond tokens of a DV pair are its bond tokens times the weight, so
+	// the delegation mints the increase of that product
+	bondAmount := math.ZeroInt()
+	if bondTokens, found := k.GetDVPairBondTokens(ctx, delAddr, valAddr); found {
+		bondAmount = bondTokens.Amount
+	}
+	sdkBondAmount := weight.MulInt(bondAmount.Add(amount.Amount)).TruncateInt().Sub(weight.MulInt(bondAmount).TruncateInt())
+	if !sdkBondAmount.IsPositive() {
+		return nil, math.Int{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "delegation amount %s is too small", amount)
+	}
+	if err := k.validateStakingCaps(ctx, amount, sdkBondAmount); err != nil {
+		return nil, math.Int{}, err
+	}
+
+	intermediaryAccount := types.IntermediaryAccount(delAddr, valAddr)
+	if _, found := k.GetIntermediaryAccountDelegator(ctx, intermediaryAccount); !found {
+		k.SetIntermediaryAccountDelegator(ctx, intermediaryAccount, delAddr)
+	}
+	if err := k.setRewardsWithdrawAddr(ctx, intermediaryAccount, delAddr); err != nil {
+		return nil, math.Int{}, err
+	}
+
+	if err := k.lockBondTokens(ctx, delAddr, intermediaryAccount, amount); err != nil {
+		return nil, math.Int{}, err
+	}
+
+	sdkBondTokens, err := k.mintSDKBondTokens(ctx, intermediaryAccount, sdkBondAmount)
+	if err != nil {
+		return nil, math.Int{}, err
+	}
+
+	k.addDVPairTokens(ctx, delAddr, valAddr, amount, sdkBondTokens)
+
+	return intermediaryAccount, sdkBondAmount, nil
+}
+
+// lockBondTokens locks bond tokens of a delegator in the intermediary account
+// of its DV pair. They are delegated the way the staking module delegates, so
+// that vesting accounts can lock their vesting bond tokens, which the bank
+// keeper tracks as delegated.
+func (k Keeper) lockBondTokens(ctx sdk.Context, delAddr, intermediaryAccount sdk.AccAddress, bondTokens sdk.Coin) error {
+	if !k.accountKeeper.HasAccount(ctx, intermediaryAccount) {
+		k.accountKeeper.SetAccount(ctx, k.accountKeeper.NewAccountWithAddress(ctx, intermediaryAccount))
+	}
+	return k.bankKeeper.DelegateCoins(ctx, delAddr, intermediaryAccount, sdk.NewCoins(bondTokens))
+}
+
+// mintSDKBondTokens mints sdkbond tokens to an intermediary account and adds
+// them to the sdkbond tokens issued by the module
+func (k Keeper) mintSDKBondTokens(ctx sdk.Context, intermediaryAccount sdk.AccAddress, amount math.Int) (sdk.Coin, error) {
+	sdkBondTokens := sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), amount)
+	coins := sdk.NewCoins(sdkBondTokens)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, intermediaryAccount, coins); err != nil {
+		return sdk.Coin{}, err
+	}
+	k.addIssuedSDKBondTokens(ctx, amount)
+	return sdkBondTokens, nil
+}
+
+// addDVPairTokens adds the locked bond tokens and the minted sdkbond tokens to
+// the records of a DV pair
+func (k Keeper) addDVPairTokens(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, bondTokens, sdkBondTokens sdk.Coin) {
+	if current, found := k.GetDVPairBondTokens(ctx, delAddr, valAddr); found {
+		bondTokens = bondTokens.Add(current)
+	}
+	k.SetDVPairBondTokens(ctx, delAddr, valAddr, bondTokens)
+
+	if current, found := k.GetDVPairSDKBondTokens(ctx, delAddr, valAddr); found {
+		sdkBondTokens = sdkBondTokens.Add(current)
+	}
+	k.SetDVPairSDKBondTokens(ctx, delAddr, valAddr, sdkBondTokens)
+}
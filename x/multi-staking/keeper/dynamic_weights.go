@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// UpdateDynamicWeights recomputes the weights of the bond denoms with weight
+// bounds from the weight provider, once every WeightEpochLength blocks. The
+// weight given by the provider is moved at most the max change rate away from
+// the current weight and kept within the min and max weights. A new weight
+// starts a reweighting job, like a change bond token weight proposal. A bond
+// denom the provider has no weight for keeps its weight until the next epoch,
+// and sunsetting bond denoms are skipped.
+func (k Keeper) UpdateDynamicWeights(ctx sdk.Context) {
+	if k.weightProvider == nil || ctx.BlockHeight()%int64(k.WeightEpochLength(ctx)) != 0 {
+		return
+	}
+
+	var denoms []string
+	bounds := make(map[string]types.WeightBounds)
+	k.IterateWeightBounds(ctx, func(denom string, b types.WeightBounds) bool {
+		denoms = append(denoms, denom)
+		bounds[denom] = b
+		return false
+	})
+
+	for _, denom := range denoms {
+		weight, found := k.GetBondTokenWeight(ctx, denom)
+		if !found || k.IsBondDenomSunsetting(ctx, denom) {
+			continue
+		}
+
+		target, err := k.weightProvider.GetBondTokenWeight(ctx, denom)
+		if err == nil && (target.IsNil() || !target.IsPositive()) {
+			err = types.ErrInvalidBondTokenWeight
+		}
+		if err != nil {
+			k.Logger(ctx).Info("failed to get bond token weight from the weight provider", "bond_denom", denom, "err", err)
+			continue
+		}
+
+		newWeight := bounds[denom].Bound(weight, target)
+		if newWeight.Equal(weight) {
+			continue
+		}
+		k.changeBondTokenWeight(ctx, denom, newWeight)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeUpdateWeight,
+				sdk.NewAttribute(types.AttributeKeyBondDenom, denom),
+				sdk.NewAttribute(types.AttributeKeyOldWeight, weight.String()),
+				sdk.NewAttribute(types.AttributeKeyNewWeight, newWeight.String()),
+			),
+		)
+	}
+}
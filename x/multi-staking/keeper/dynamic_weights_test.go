@@ -0,0 +1,201 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	multistaking "github.com/notional-labs/multi-staking-module/x/multi-staking"
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// mockWeightProvider is a weight provider of fixed weights
+type mockWeightProvider map[string]sdk.Dec
+
+func (p mockWeightProvider) GetBondTokenWeight(_ sdk.Context, denom string) (sdk.Dec, error) {
+	weight, found := p[denom]
+	if !found {
+		return sdk.Dec{}, fmt.Errorf("no price for %s", denom)
+	}
+	return weight, nil
+}
+
+const weightEpochLength = 10
+
+// setWeightBounds executes a passed set weight bounds proposal
+func (suite *KeeperTestSuite) setWeightBounds(denom, minWeight, maxWeight, maxChangeRate string) {
+	handler := multistaking.NewProposalHandler(suite.msKeeper)
+	bounds := types.NewWeightBounds(
+		sdk.MustNewDecFromStr(minWeight), sdk.MustNewDecFromStr(maxWeight), sdk.MustNewDecFromStr(maxChangeRate),
+	)
+	suite.Require().NoError(handler(suite.ctx, types.NewSetWeightBoundsProposal("title", "description", denom, bounds)))
+}
+
+func (suite *KeeperTestSuite) TestUpdateDynamicWeights() {
+	testCases := []struct {
+		name          string
+		provided      string
+		maxChangeRate string
+		height        int64
+		malleate      func()
+		expWeight     sdk.Dec
+	}{
+		{
+			name:          "provided weight within the bounds",
+			provided:      "0.52",
+			maxChangeRate: "0.1",
+			height:        weightEpochLength,
+			expWeight:     sdk.MustNewDecFromStr("0.52"),
+		},
+		{
+			name:          "weight increase above the max change rate",
+			provided:      "0.8",
+			maxChangeRate: "0.1",
+			height:        weightEpochLength,
+			expWeight:     sdk.MustNewDecFromStr("0.55"),
+		},
+		{
+			name:          "weight decrease above the max change rate",
+			provided:      "0.3",
+			maxChangeRate: "0.1",
+			height:        weightEpochLength,
+			expWeight:     sdk.MustNewDecFromStr("0.45"),
+		},
+		{
+			name:          "provided weight below the min weight",
+			provided:      "0.1",
+			maxChangeRate: "0",
+			height:        weightEpochLength,
+			expWeight:     sdk.MustNewDecFromStr("0.25"),
+		},
+		{
+			name:          "provided weight above the max weight",
+			provided:      "3",
+			maxChangeRate: "0",
+			height:        weightEpochLength,
+			expWeight:     sdk.OneDec(),
+		},
+		{
+			name:          "not at the start of an epoch",
+			provided:      "0.52",
+			maxChangeRate: "0.1",
+			height:        weightEpochLength + 1,
+			expWeight:     bondWeight,
+		},
+		{
+			name:          "no weight provided",
+			maxChangeRate: "0.1",
+			height:        weightEpochLength,
+			expWeight:     bondWeight,
+		},
+		{
+			name:          "non-positive weight provided",
+			provided:      "0",
+			maxChangeRate: "0",
+			height:        weightEpochLength,
+			expWeight:     bondWeight,
+		},
+		{
+			name:          "static weight",
+			provided:      "0.52",
+			maxChangeRate: "0.1",
+			height:        weightEpochLength,
+			malleate:      func() { suite.setWeightBounds(bondDenom, "0", "0", "0") },
+			expWeight:     bondWeight,
+		},
+		{
+			name:          "sunsetting bond denom",
+			provided:      "0.52",
+			maxChangeRate: "0.1",
+			height:        weightEpochLength,
+			malleate:      func() { suite.Require().NoError(suite.msKeeper.SunsetBondDenom(suite.ctx, bondDenom)) },
+			expWeight:     bondWeight,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			params := suite.msKeeper.GetParams(suite.ctx)
+			params.WeightEpochLength = weightEpochLength
+			suite.msKeeper.SetParams(suite.ctx, params)
+
+			provider := mockWeightProvider{}
+			if tc.provided != "" {
+				provider[bondDenom] = sdk.MustNewDecFromStr(tc.provided)
+			}
+			suite.msKeeper.SetWeightProvider(provider)
+			suite.setWeightBounds(bondDenom, "0.25", "1", tc.maxChangeRate)
+			if tc.malleate != nil {
+				tc.malleate()
+			}
+
+			suite.ctx = suite.ctx.WithBlockHeight(tc.height)
+			suite.msKeeper.UpdateDynamicWeights(suite.ctx)
+
+			weight, _ := suite.msKeeper.GetBondTokenWeight(suite.ctx, bondDenom)
+			suite.Require().Equal(tc.expWeight, weight)
+			reweighting, found := suite.msKeeper.GetReweighting(suite.ctx, bondDenom)
+			if tc.expWeight.Equal(bondWeight) {
+				suite.Require().False(found)
+				return
+			}
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expWeight, reweighting.BondTokenWeight)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDynamicWeightReweightsDVPairs() {
+	suite.disableInflation()
+	valAddr := suite.validator.GetOperator()
+	delAddr := suite.delegateAll(valAddr, 1000)[0]
+
+	params := suite.msKeeper.GetParams(suite.ctx)
+	params.WeightEpochLength = weightEpochLength
+	suite.msKeeper.SetParams(suite.ctx, params)
+	suite.msKeeper.SetWeightProvider(mockWeightProvider{bondDenom: sdk.MustNewDecFromStr("0.8")})
+	suite.setWeightBounds(bondDenom, "0.25", "1", "0.2")
+
+	// the new weight is reweighted in the same block
+	suite.ctx = suite.ctx.WithBlockHeight(weightEpochLength)
+	multistaking.EndBlocker(suite.ctx, suite.msKeeper)
+	sdkBondTokens, found := suite.msKeeper.GetDVPairSDKBondTokens(suite.ctx, delAddr, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(600), sdkBondTokens.Amount)
+	suite.requireInvariant()
+
+	res, err := suite.queryClient.BondTokenWeight(sdk.WrapSDKContext(suite.ctx), &types.QueryBondTokenWeightRequest{BondDenom: bondDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.6"), res.BondTokenWeight.BondTokenWeight)
+	suite.Require().NotNil(res.WeightBounds)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.2"), res.WeightBounds.MaxChangeRate)
+}
+
+func (suite *KeeperTestSuite) TestSetWeightBoundsProposal() {
+	handler := multistaking.NewProposalHandler(suite.msKeeper)
+	bounds := types.NewWeightBounds(sdk.MustNewDecFromStr("0.25"), sdk.OneDec(), sdk.MustNewDecFromStr("0.1"))
+
+	err := handler(suite.ctx, types.NewSetWeightBoundsProposal("title", "description", "uatom", bounds))
+	suite.Require().ErrorIs(err, types.ErrInvalidBondDenom)
+
+	suite.Require().NoError(handler(suite.ctx, types.NewSetWeightBoundsProposal("title", "description", bondDenom, bounds)))
+	stored, found := suite.msKeeper.GetWeightBounds(suite.ctx, bondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(bounds, stored)
+
+	// zero bounds make the weight static again
+	zero := types.NewWeightBounds(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	suite.Require().NoError(handler(suite.ctx, types.NewSetWeightBoundsProposal("title", "description", bondDenom, zero)))
+	_, found = suite.msKeeper.GetWeightBounds(suite.ctx, bondDenom)
+	suite.Require().False(found)
+
+	// sunsetting the bond denom removes its weight bounds
+	suite.Require().NoError(handler(suite.ctx, types.NewSetWeightBoundsProposal("title", "description", bondDenom, bounds)))
+	suite.removeBondToken(bondDenom)
+	err = handler(suite.ctx, types.NewSetWeightBoundsProposal("title", "description", bondDenom, bounds))
+	suite.Require().ErrorIs(err, types.ErrBondDenomSunsetting)
+	suite.nextBlock(suite.ctx.BlockTime())
+	_, found = suite.msKeeper.GetWeightBounds(suite.ctx, bondDenom)
+	suite.Require().False(found)
+}
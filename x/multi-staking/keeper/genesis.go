@@ -0,0 +1,191 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// InitGenesis sets the multi-staking module state from a genesis state.
+//
+// NOTE: it must run after the staking InitGenesis, since the DV pairs refer to
+// the sdk delegations of the intermediary accounts and the sdkbond denom is
+// the bond denom of the staking module.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	if err := genState.ValidateSDKBondDenom(k.stakingKeeper.BondDenom(ctx)); err != nil {
+		panic(err)
+	}
+
+	k.SetParams(ctx, genState.Params)
+
+	for _, weight := range genState.BondTokenWeights {
+		k.SetBondTokenWeight(ctx, weight.BondDenom, weight.BondTokenWeight)
+	}
+
+	for _, v := range genState.ValidatorBondDenoms {
+		valAddr, err := sdk.ValAddressFromBech32(v.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetValidatorBondDenom(ctx, valAddr, v.BondDenom)
+	}
+
+	for _, i := range genState.IntermediaryAccountDelegators {
+		k.SetIntermediaryAccountDelegator(
+			ctx, sdk.MustAccAddressFromBech32(i.IntermediaryAddress), sdk.MustAccAddressFromBech32(i.DelegatorAddress),
+		)
+	}
+
+	for _, s := range genState.DVPairSDKBondTokens {
+		delAddr, valAddr := mustParseDVPair(s.DelegatorAddress, s.ValidatorAddress)
+		k.SetDVPairSDKBondTokens(ctx, delAddr, valAddr, s.SDKBondTokens)
+	}
+
+	for _, b := range genState.DVPairBondTokens {
+		delAddr, valAddr := mustParseDVPair(b.DelegatorAddress, b.ValidatorAddress)
+		k.SetDVPairBondTokens(ctx, delAddr, valAddr, b.BondTokens)
+	}
+
+	for _, u := range genState.DVPairUnbondingTokens {
+		delAddr, valAddr := mustParseDVPair(u.DelegatorAddress, u.ValidatorAddress)
+		k.SetDVPairUnbondingTokens(ctx, delAddr, valAddr, u.CompletionTime, u.UnbondingTokens)
+	}
+
+	for _, s := range genState.BondDenomSunsetHeights {
+		k.SetBondDenomSunsetHeight(ctx, s.BondDenom, s.Height)
+	}
+
+	for _, c := range genState.SunsetCursors {
+		k.SetSunsetCursor(ctx, c.BondDenom, c.NextDvPair)
+	}
+
+	for _, r := range genState.Reweightings {
+		k.SetReweighting(ctx, r.BondDenom, r.Reweighting)
+	}
+
+	for _, c := range genState.StakingCaps {
+		k.SetStakingCaps(ctx, c.BondDenom, c.Caps)
+	}
+
+	for _, b := range genState.WeightBounds {
+		k.SetWeightBounds(ctx, b.BondDenom, b.Bounds)
+	}
+
+	if !genState.IssuedSDKBondTokens.IsNil() {
+		k.SetIssuedSDKBondTokens(ctx, genState.IssuedSDKBondTokens)
+	}
+}
+
+// ExportGenesis returns the multi-staking module state as a genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	genState := &types.GenesisState{
+		Params:              k.GetParams(ctx),
+		IssuedSDKBondTokens: k.GetIssuedSDKBondTokens(ctx),
+	}
+
+	k.IterateBondTokenWeights(ctx, func(denom string, weight sdk.Dec) bool {
+		genState.BondTokenWeights = append(genState.BondTokenWeights, types.BondTokenWeight{
+			BondDenom:       denom,
+			BondTokenWeight: weight,
+		})
+		return false
+	})
+
+	k.IterateValidatorBondDenoms(ctx, func(valAddr sdk.ValAddress, denom string) bool {
+		genState.ValidatorBondDenoms = append(genState.ValidatorBondDenoms, types.ValidatorBondDenom{
+			ValidatorAddress: valAddr.String(),
+			BondDenom:        denom,
+		})
+		return false
+	})
+
+	k.IterateIntermediaryAccountDelegators(ctx, func(intermediaryAccount, delAddr sdk.AccAddress) bool {
+		genState.IntermediaryAccountDelegators = append(genState.IntermediaryAccountDelegators, types.IntermediaryAccountDelegator{
+			IntermediaryAddress: intermediaryAccount.String(),
+			DelegatorAddress:    delAddr.String(),
+		})
+		return false
+	})
+
+	k.IterateDVPairSDKBondTokens(ctx, func(delAddr sdk.AccAddress, valAddr sdk.ValAddress, sdkBondTokens sdk.Coin) bool {
+		genState.DVPairSDKBondTokens = append(genState.DVPairSDKBondTokens, types.DVPairSDKBondTokens{
+			DelegatorAddress: delAddr.String(),
+			ValidatorAddress: valAddr.String(),
+			SDKBondTokens:    sdkBondTokens,
+		})
+		return false
+	})
+
+	k.IterateDVPairBondTokens(ctx, func(delAddr sdk.AccAddress, valAddr sdk.ValAddress, bondTokens sdk.Coin) bool {
+		genState.DVPairBondTokens = append(genState.DVPairBondTokens, types.DVPairBondTokens{
+			DelegatorAddress: delAddr.String(),
+			ValidatorAddress: valAddr.String(),
+			BondTokens:       bondTokens,
+		})
+		return false
+	})
+
+	k.IterateDVPairUnbondingTokens(ctx, func(
+		delAddr sdk.AccAddress, valAddr sdk.ValAddress, completionTime time.Time, tokens types.UnbondingTokens,
+	) bool {
+		genState.DVPairUnbondingTokens = append(genState.DVPairUnbondingTokens, types.DVPairUnbondingTokens{
+			DelegatorAddress: delAddr.String(),
+			ValidatorAddress: valAddr.String(),
+			CompletionTime:   completionTime,
+			UnbondingTokens:  tokens,
+		})
+		return false
+	})
+
+	k.IterateBondDenomSunsetHeights(ctx, func(denom string, height int64) bool {
+		genState.BondDenomSunsetHeights = append(genState.BondDenomSunsetHeights, types.BondDenomSunsetHeight{
+			BondDenom: denom,
+			Height:    height,
+		})
+		return false
+	})
+
+	k.IterateSunsetCursors(ctx, func(denom string, next []byte) bool {
+		genState.SunsetCursors = append(genState.SunsetCursors, types.BondDenomSunsetCursor{
+			BondDenom:  denom,
+			NextDvPair: next,
+		})
+		return false
+	})
+
+	k.IterateReweightings(ctx, func(denom string, reweighting types.Reweighting) bool {
+		genState.Reweightings = append(genState.Reweightings, types.BondDenomReweighting{
+			BondDenom:   denom,
+			Reweighting: reweighting,
+		})
+		return false
+	})
+
+	k.IterateStakingCaps(ctx, func(denom string, caps types.StakingCaps) bool {
+		genState.StakingCaps = append(genState.StakingCaps, types.BondDenomStakingCaps{
+			BondDenom: denom,
+			Caps:      caps,
+		})
+		return false
+	})
+
+	k.IterateWeightBounds(ctx, func(denom string, bounds types.WeightBounds) bool {
+		genState.WeightBounds = append(genState.WeightBounds, types.BondDenomWeightBounds{
+			BondDenom: denom,
+			Bounds:    bounds,
+		})
+		return false
+	})
+
+	return genState
+}
+
+func mustParseDVPair(delegator, validator string) (sdk.AccAddress, sdk.ValAddress) {
+	valAddr, err := sdk.ValAddressFromBech32(validator)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustAccAddressFromBech32(delegator), valAddr
+}
@@ -0,0 +1,239 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/notional-labs/multi-staking-module/testing/simapp"
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+func (suite *KeeperTestSuite) TestExportImportGenesis() {
+	valAddr := suite.validator.GetOperator()
+	delegated := sdk.NewInt64Coin(bondDenom, 1000)
+	delAddr := suite.fundedAccount(sdk.NewCoins(delegated))
+	_, err := suite.msgServer.Delegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgDelegate(delAddr, valAddr, delegated))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Undelegate(
+		sdk.WrapSDKContext(suite.ctx), types.NewMsgUndelegate(delAddr, valAddr, sdk.NewInt64Coin(bondDenom, 400)),
+	)
+	suite.Require().NoError(err)
+
+	// a denom being sunset and a weight change in progress are exported too
+	suite.msKeeper.SetBondTokenWeight(suite.ctx, sunsetDenom, sdk.OneDec())
+	suite.removeBondToken(sunsetDenom)
+	suite.msKeeper.SetSunsetCursor(suite.ctx, sunsetDenom, types.GetDVPairKey(delAddr, valAddr))
+	suite.changeBondTokenWeight(bondDenom, sdk.MustNewDecFromStr("0.25"))
+	suite.msKeeper.SetStakingCaps(suite.ctx, bondDenom, types.NewStakingCaps(sdk.NewInt(5000), sdk.MustNewDecFromStr("0.3")))
+	suite.msKeeper.SetWeightBounds(suite.ctx, bondDenom, types.NewWeightBounds(sdk.MustNewDecFromStr("0.1"), sdk.OneDec(), sdk.ZeroDec()))
+
+	genState := suite.msKeeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(genState.Validate())
+	suite.Require().Len(genState.BondTokenWeights, 2)
+	suite.Require().Len(genState.ValidatorBondDenoms, 1)
+	suite.Require().Len(genState.IntermediaryAccountDelegators, 1)
+	suite.Require().Len(genState.DVPairBondTokens, 1)
+	suite.Require().Len(genState.DVPairSDKBondTokens, 1)
+	suite.Require().Len(genState.DVPairUnbondingTokens, 1)
+	suite.Require().Len(genState.BondDenomSunsetHeights, 1)
+	suite.Require().Len(genState.SunsetCursors, 1)
+	suite.Require().Len(genState.Reweightings, 1)
+	suite.Require().Len(genState.StakingCaps, 1)
+	suite.Require().Len(genState.WeightBounds, 1)
+
+	// importing the state into a fresh chain exports the same state again
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	// the genesis validator of the fresh chain is not part of the imported state
+	app.MultiStakingKeeper.DeleteValidatorBondDenom(ctx, app.StakingKeeper.GetAllValidators(ctx)[0].GetOperator())
+	app.MultiStakingKeeper.InitGenesis(ctx, *genState)
+	suite.Require().Equal(genState, app.MultiStakingKeeper.ExportGenesis(ctx))
+}
+
+func (suite *KeeperTestSuite) TestValidateGenesis() {
+	_, _, delAddr := testdata.KeyTestPubAddr()
+	valAddr := suite.validator.GetOperator()
+	intermediaryAccount := types.IntermediaryAccount(delAddr, valAddr)
+	completionTime := time.Unix(1000, 0).UTC()
+
+	// validGenesis returns a genesis state with a single DV pair which is
+	// unbonding part of its delegation
+	validGenesis := func() *types.GenesisState {
+		genState := types.NewGenesisState(
+			types.DefaultParams(),
+			[]types.BondTokenWeight{{BondDenom: bondDenom, BondTokenWeight: bondWeight}},
+			[]types.ValidatorBondDenom{{ValidatorAddress: valAddr.String(), BondDenom: bondDenom}},
+		)
+		genState.IntermediaryAccountDelegators = []types.IntermediaryAccountDelegator{
+			{IntermediaryAddress: intermediaryAccount.String(), DelegatorAddress: delAddr.String()},
+		}
+		genState.DVPairBondTokens = []types.DVPairBondTokens{
+			{DelegatorAddress: delAddr.String(), ValidatorAddress: valAddr.String(), BondTokens: sdk.NewInt64Coin(bondDenom, 600)},
+		}
+		genState.DVPairSDKBondTokens = []types.DVPairSDKBondTokens{
+			{DelegatorAddress: delAddr.String(), ValidatorAddress: valAddr.String(), SDKBondTokens: sdk.NewInt64Coin(sdk.DefaultBondDenom, 300)},
+		}
+		genState.DVPairUnbondingTokens = []types.DVPairUnbondingTokens{
+			{
+				DelegatorAddress: delAddr.String(),
+				ValidatorAddress: valAddr.String(),
+				CompletionTime:   completionTime,
+				UnbondingTokens: types.UnbondingTokens{
+					BondTokens:    sdk.NewInt64Coin(bondDenom, 400),
+					SDKBondTokens: sdk.NewInt64Coin(sdk.DefaultBondDenom, 200),
+				},
+			},
+		}
+		genState.IssuedSDKBondTokens = sdk.NewInt(500)
+		return genState
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(genState *types.GenesisState)
+		expErr   bool
+	}{
+		{
+			name:     "default genesis",
+			malleate: func(genState *types.GenesisState) { *genState = *types.DefaultGenesisState() },
+		},
+		{
+			name:     "issued sdkbond tokens do not match the DV pairs",
+			malleate: func(genState *types.GenesisState) { genState.IssuedSDKBondTokens = sdk.NewInt(300) },
+			expErr:   true,
+		},
+		{
+			name:     "valid genesis",
+			malleate: func(genState *types.GenesisState) {},
+		},
+		{
+			name: "non-positive bond token weight",
+			malleate: func(genState *types.GenesisState) {
+				genState.BondTokenWeights[0].BondTokenWeight = sdk.ZeroDec()
+			},
+			expErr: true,
+		},
+		{
+			name: "duplicate bond token weight",
+			malleate: func(genState *types.GenesisState) {
+				genState.BondTokenWeights = append(genState.BondTokenWeights, genState.BondTokenWeights[0])
+			},
+			expErr: true,
+		},
+		{
+			name: "validator pinned to an unknown bond denom",
+			malleate: func(genState *types.GenesisState) {
+				genState.ValidatorBondDenoms[0].BondDenom = "uatom"
+			},
+			expErr: true,
+		},
+		{
+			name: "DV pair without intermediary account",
+			malleate: func(genState *types.GenesisState) {
+				genState.IntermediaryAccountDelegators = nil
+			},
+			expErr: true,
+		},
+		{
+			name: "bond tokens of another denom than the validator",
+			malleate: func(genState *types.GenesisState) {
+				genState.DVPairBondTokens[0].BondTokens = sdk.NewInt64Coin("uatom", 600)
+			},
+			expErr: true,
+		},
+		{
+			name: "sdkbond tokens without bond tokens",
+			malleate: func(genState *types.GenesisState) {
+				genState.DVPairBondTokens = nil
+			},
+			expErr: true,
+		},
+		{
+			name: "duplicate unbonding tokens",
+			malleate: func(genState *types.GenesisState) {
+				genState.DVPairUnbondingTokens = append(genState.DVPairUnbondingTokens, genState.DVPairUnbondingTokens[0])
+			},
+			expErr: true,
+		},
+		{
+			name: "sunset height of an unknown bond denom",
+			malleate: func(genState *types.GenesisState) {
+				genState.BondDenomSunsetHeights = []types.BondDenomSunsetHeight{{BondDenom: "uatom", Height: 1}}
+			},
+			expErr: true,
+		},
+		{
+			name: "sunset cursor of a bond denom not sunsetting",
+			malleate: func(genState *types.GenesisState) {
+				genState.SunsetCursors = []types.BondDenomSunsetCursor{
+					{BondDenom: bondDenom, NextDvPair: types.GetDVPairKey(delAddr, valAddr)},
+				}
+			},
+			expErr: true,
+		},
+		{
+			name: "reweighting to a non-positive weight",
+			malleate: func(genState *types.GenesisState) {
+				genState.Reweightings = []types.BondDenomReweighting{
+					{BondDenom: bondDenom, Reweighting: types.Reweighting{BondTokenWeight: sdk.ZeroDec()}},
+				}
+			},
+			expErr: true,
+		},
+		{
+			name: "staking caps of an unknown bond denom",
+			malleate: func(genState *types.GenesisState) {
+				genState.StakingCaps = []types.BondDenomStakingCaps{
+					{BondDenom: "uatom", Caps: types.NewStakingCaps(sdk.NewInt(1000), sdk.ZeroDec())},
+				}
+			},
+			expErr: true,
+		},
+		{
+			name: "max power share above one",
+			malleate: func(genState *types.GenesisState) {
+				genState.StakingCaps = []types.BondDenomStakingCaps{
+					{BondDenom: bondDenom, Caps: types.NewStakingCaps(sdk.ZeroInt(), sdk.NewDec(2))},
+				}
+			},
+			expErr: true,
+		},
+		{
+			name: "max weight below the min weight",
+			malleate: func(genState *types.GenesisState) {
+				genState.WeightBounds = []types.BondDenomWeightBounds{
+					{BondDenom: bondDenom, Bounds: types.NewWeightBounds(sdk.OneDec(), sdk.MustNewDecFromStr("0.5"), sdk.ZeroDec())},
+				}
+			},
+			expErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			genState := validGenesis()
+			tc.malleate(genState)
+			err := genState.Validate()
+			if tc.expErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestInitGenesisSDKBondDenom() {
+	genState := types.DefaultGenesisState()
+	genState.BondTokenWeights = []types.BondTokenWeight{
+		{BondDenom: suite.app.StakingKeeper.BondDenom(suite.ctx), BondTokenWeight: sdk.OneDec()},
+	}
+	suite.Require().NoError(genState.Validate())
+
+	// the sdkbond denom cannot back itself
+	suite.Require().Error(genState.ValidateSDKBondDenom(suite.app.StakingKeeper.BondDenom(suite.ctx)))
+	suite.Require().Panics(func() { suite.msKeeper.InitGenesis(suite.ctx, *genState) })
+}
@@ -0,0 +1,346 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper
+type Querier struct {
+	Keeper
+}
+
+var _ types.QueryServer = Querier{}
+
+// IntermediaryAccount queries the intermediary account of a (delegator, validator) pair
+func (k Querier) IntermediaryAccount(c context.Context, req *types.QueryIntermediaryAccountRequest) (*types.QueryIntermediaryAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	intermediaryAccount := k.IntermediaryAccountFromDelegator(delAddr, valAddr)
+	return &types.QueryIntermediaryAccountResponse{IntermediaryAddress: intermediaryAccount.String()}, nil
+}
+
+// IntermediaryAccountDelegator queries the delegator an intermediary account acts for
+func (k Querier) IntermediaryAccountDelegator(
+	c context.Context, req *types.QueryIntermediaryAccountDelegatorRequest,
+) (*types.QueryIntermediaryAccountDelegatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	intermediaryAccount, err := sdk.AccAddressFromBech32(req.IntermediaryAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	delAddr, found := k.DelegatorFromIntermediaryAccount(ctx, intermediaryAccount)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "intermediary account %s not found", req.IntermediaryAddress)
+	}
+
+	return &types.QueryIntermediaryAccountDelegatorResponse{DelegatorAddress: delAddr.String()}, nil
+}
+
+// BondTokenWeights queries the accepted bond denoms and their weights
+func (k Querier) BondTokenWeights(c context.Context, req *types.QueryBondTokenWeightsRequest) (*types.QueryBondTokenWeightsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BondTokenWeightKey)
+
+	var weights []types.BondTokenWeightInfo
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		var weight sdk.DecProto
+		if err := k.cdc.Unmarshal(value, &weight); err != nil {
+			return err
+		}
+		weights = append(weights, types.BondTokenWeightInfo{
+			BondDenom:       string(key),
+			BondTokenWeight: weight.Dec,
+			BaseDenom:       k.BaseDenom(ctx, string(key)),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBondTokenWeightsResponse{BondTokenWeights: weights, Pagination: pageRes}, nil
+}
+
+// BondTokenWeight queries the weight of a bond denom
+func (k Querier) BondTokenWeight(c context.Context, req *types.QueryBondTokenWeightRequest) (*types.QueryBondTokenWeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := sdk.ValidateDenom(req.BondDenom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	weight, found := k.GetBondTokenWeight(ctx, req.BondDenom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "bond denom %s not found", req.BondDenom)
+	}
+
+	res := &types.QueryBondTokenWeightResponse{
+		BondTokenWeight: types.BondTokenWeightInfo{BondDenom: req.BondDenom, BondTokenWeight: weight, BaseDenom: k.BaseDenom(ctx, req.BondDenom)},
+		Sunsetting:      k.IsBondDenomSunsetting(ctx, req.BondDenom),
+	}
+	if bounds, found := k.GetWeightBounds(ctx, req.BondDenom); found {
+		res.WeightBounds = &bounds
+	}
+	return res, nil
+}
+
+// StakingHeadrooms queries the staking caps of the bond denoms and the bond tokens that can still be delegated within them
+func (k Querier) StakingHeadrooms(c context.Context, req *types.QueryStakingHeadroomsRequest) (*types.QueryStakingHeadroomsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BondTokenWeightKey)
+
+	var headrooms []types.StakingHeadroom
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		headrooms = append(headrooms, k.GetStakingHeadroom(ctx, string(key)))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryStakingHeadroomsResponse{Headrooms: headrooms, Pagination: pageRes}, nil
+}
+
+// StakingHeadroom queries the staking caps of a bond denom and the bond tokens that can still be delegated within them
+func (k Querier) StakingHeadroom(c context.Context, req *types.QueryStakingHeadroomRequest) (*types.QueryStakingHeadroomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := sdk.ValidateDenom(req.BondDenom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if !k.IsBondDenom(ctx, req.BondDenom) {
+		return nil, status.Errorf(codes.NotFound, "bond denom %s not found", req.BondDenom)
+	}
+
+	return &types.QueryStakingHeadroomResponse{Headroom: k.GetStakingHeadroom(ctx, req.BondDenom)}, nil
+}
+
+// ValidatorBondDenom queries the bond denom a validator is pinned to
+func (k Querier) ValidatorBondDenom(c context.Context, req *types.QueryValidatorBondDenomRequest) (*types.QueryValidatorBondDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	denom, found := k.GetValidatorBondDenom(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator %s has no bond denom", req.ValidatorAddress)
+	}
+
+	return &types.QueryValidatorBondDenomResponse{BondDenom: denom, BaseDenom: k.BaseDenom(ctx, denom)}, nil
+}
+
+// MultiStakingDelegation queries the multi-staking delegation of a (delegator, validator) pair
+func (k Querier) MultiStakingDelegation(
+	c context.Context, req *types.QueryMultiStakingDelegationRequest,
+) (*types.QueryMultiStakingDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	bondTokens, found := k.GetDVPairBondTokens(ctx, delAddr, valAddr)
+	if !found {
+		return nil, status.Errorf(
+			codes.NotFound, "delegation with delegator %s not found for validator %s", req.DelegatorAddress, req.ValidatorAddress,
+		)
+	}
+
+	return &types.QueryMultiStakingDelegationResponse{Delegation: k.multiStakingDelegation(ctx, delAddr, valAddr, bondTokens)}, nil
+}
+
+// DelegatorMultiStakingDelegations queries all the multi-staking delegations of a delegator
+func (k Querier) DelegatorMultiStakingDelegations(
+	c context.Context, req *types.QueryDelegatorMultiStakingDelegationsRequest,
+) (*types.QueryDelegatorMultiStakingDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.DVPairBondTokenKey, types.GetDelegatorDVPairsPrefix(delAddr)...))
+
+	var delegations []types.MultiStakingDelegation
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		var bondTokens sdk.Coin
+		if err := k.cdc.Unmarshal(value, &bondTokens); err != nil {
+			return err
+		}
+		_, valAddr := types.ParseDVPairKey(append(types.GetDelegatorDVPairsPrefix(delAddr), key...))
+		delegations = append(delegations, k.multiStakingDelegation(ctx, delAddr, valAddr, bondTokens))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDelegatorMultiStakingDelegationsResponse{Delegations: delegations, Pagination: pageRes}, nil
+}
+
+// MultiStakingUnbondings queries all the multi-staking unbondings of a delegator
+func (k Querier) MultiStakingUnbondings(
+	c context.Context, req *types.QueryMultiStakingUnbondingsRequest,
+) (*types.QueryMultiStakingUnbondingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.DVPairUnbondingTokensKey, types.GetDelegatorDVPairsPrefix(delAddr)...))
+
+	var unbondings []types.MultiStakingUnbonding
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		var tokens types.UnbondingTokens
+		if err := k.cdc.Unmarshal(value, &tokens); err != nil {
+			return err
+		}
+		_, valAddr, completionTime := types.ParseDVPairUnbondingTokensKey(append(types.GetDelegatorDVPairsPrefix(delAddr), key...))
+		unbondings = append(unbondings, k.multiStakingUnbonding(ctx, delAddr, valAddr, completionTime, tokens))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMultiStakingUnbondingsResponse{Unbondings: unbondings, Pagination: pageRes}, nil
+}
+
+// multiStakingDelegation returns the multi-staking delegation of a DV pair with
+// the sdk delegation of its intermediary account
+func (k Querier) multiStakingDelegation(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, bondTokens sdk.Coin,
+) types.MultiStakingDelegation {
+	sdkBondDenom := k.stakingKeeper.BondDenom(ctx)
+	intermediaryAccount := types.IntermediaryAccount(delAddr, valAddr)
+
+	sdkBondTokens, found := k.GetDVPairSDKBondTokens(ctx, delAddr, valAddr)
+	if !found {
+		sdkBondTokens = sdk.NewCoin(sdkBondDenom, math.ZeroInt())
+	}
+
+	shares, balance := sdk.ZeroDec(), math.ZeroInt()
+	if delegation, found := k.stakingKeeper.GetDelegation(ctx, intermediaryAccount, valAddr); found {
+		shares = delegation.Shares
+		if validator, found := k.stakingKeeper.GetValidator(ctx, valAddr); found {
+			balance = validator.TokensFromShares(shares).TruncateInt()
+		}
+	}
+
+	return types.MultiStakingDelegation{
+		DelegatorAddress:    delAddr.String(),
+		ValidatorAddress:    valAddr.String(),
+		IntermediaryAddress: intermediaryAccount.String(),
+		BondTokens:          bondTokens,
+		SDKBondTokens:       sdkBondTokens,
+		Shares:              shares,
+		Balance:             sdk.NewCoin(sdkBondDenom, balance),
+	}
+}
+
+// multiStakingUnbonding returns the multi-staking unbonding of a DV pair
+// completing at the given time with the sdk unbonding delegation entries of
+// its intermediary account
+func (k Querier) multiStakingUnbonding(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, completionTime time.Time, tokens types.UnbondingTokens,
+) types.MultiStakingUnbonding {
+	var creationHeight int64
+	balance := math.ZeroInt()
+
+	intermediaryAccount := types.IntermediaryAccount(delAddr, valAddr)
+	if ubd, found := k.stakingKeeper.GetUnbondingDelegation(ctx, intermediaryAccount, valAddr); found {
+		for _, entry := range ubd.Entries {
+			if entry.CompletionTime.Equal(completionTime) {
+				creationHeight = entry.CreationHeight
+				balance = balance.Add(entry.Balance)
+			}
+		}
+	}
+
+	return types.MultiStakingUnbonding{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		CreationHeight:   creationHeight,
+		CompletionTime:   completionTime,
+		BondTokens:       tokens.BondTokens,
+		SDKBondTokens:    tokens.SDKBondTokens,
+		Balance:          sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), balance),
+	}
+}
+
+// ConversionReserve queries the address and the balance of the conversion reserve
+func (k Querier) ConversionReserve(c context.Context, req *types.QueryConversionReserveRequest) (*types.QueryConversionReserveResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryConversionReserveResponse{
+		Address: types.ConversionReserve().String(),
+		Balance: k.GetConversionReserveBalance(ctx),
+	}, nil
+}
@@ -0,0 +1,175 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+func (suite *KeeperTestSuite) TestIntermediaryAccountDerivation() {
+	valAddr := suite.validator.GetOperator()
+	_, _, delAddr := testdata.KeyTestPubAddr()
+	_, _, otherAddr := testdata.KeyTestPubAddr()
+
+	intermediaryAccount := suite.msKeeper.IntermediaryAccountFromDelegator(delAddr, valAddr)
+	expected := sdk.AccAddress(address.Module(types.ModuleName, append(address.MustLengthPrefix(delAddr), address.MustLengthPrefix(valAddr)...)))
+	copy(expected, types.IntermediaryAccountPrefix)
+	suite.Require().Equal(expected, intermediaryAccount)
+	suite.Require().True(types.IsIntermediaryAccount(intermediaryAccount))
+	suite.Require().False(types.IsIntermediaryAccount(delAddr))
+	suite.Require().False(types.IsIntermediaryAccount(types.ConversionReserve()))
+	suite.Require().Equal(intermediaryAccount, suite.msKeeper.IntermediaryAccountFromDelegator(delAddr, valAddr))
+	suite.Require().NotEqual(intermediaryAccount, suite.msKeeper.IntermediaryAccountFromDelegator(otherAddr, valAddr))
+	suite.Require().NotEqual(intermediaryAccount, suite.msKeeper.IntermediaryAccountFromDelegator(delAddr, sdk.ValAddress(otherAddr)))
+
+	// the delegator is only known once the intermediary account delegated
+	_, found := suite.msKeeper.DelegatorFromIntermediaryAccount(suite.ctx, intermediaryAccount)
+	suite.Require().False(found)
+
+	delegated := sdk.NewInt64Coin(bondDenom, 1000)
+	delAddr = suite.fundedAccount(sdk.NewCoins(delegated))
+	_, err := suite.msgServer.Delegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgDelegate(delAddr, valAddr, delegated))
+	suite.Require().NoError(err)
+
+	intermediaryAccount = suite.msKeeper.IntermediaryAccountFromDelegator(delAddr, valAddr)
+	found = false
+	for _, delegation := range suite.app.StakingKeeper.GetValidatorDelegations(suite.ctx, valAddr) {
+		if delegation.GetDelegatorAddr().Equals(intermediaryAccount) {
+			found = true
+		}
+	}
+	suite.Require().True(found)
+	delegator, found := suite.msKeeper.DelegatorFromIntermediaryAccount(suite.ctx, intermediaryAccount)
+	suite.Require().True(found)
+	suite.Require().Equal(delAddr, delegator)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryIntermediaryAccount() {
+	valAddr := suite.validator.GetOperator()
+	delegated := sdk.NewInt64Coin(bondDenom, 1000)
+	delAddr := suite.fundedAccount(sdk.NewCoins(delegated))
+	_, err := suite.msgServer.Delegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgDelegate(delAddr, valAddr, delegated))
+	suite.Require().NoError(err)
+	intermediaryAccount := types.IntermediaryAccount(delAddr, valAddr)
+
+	res, err := suite.queryClient.IntermediaryAccount(gocontext.Background(), &types.QueryIntermediaryAccountRequest{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(intermediaryAccount.String(), res.IntermediaryAddress)
+
+	_, err = suite.queryClient.IntermediaryAccount(gocontext.Background(), &types.QueryIntermediaryAccountRequest{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: delAddr.String(),
+	})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	delRes, err := suite.queryClient.IntermediaryAccountDelegator(gocontext.Background(), &types.QueryIntermediaryAccountDelegatorRequest{
+		IntermediaryAddress: intermediaryAccount.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(delAddr.String(), delRes.DelegatorAddress)
+
+	_, err = suite.queryClient.IntermediaryAccountDelegator(gocontext.Background(), &types.QueryIntermediaryAccountDelegatorRequest{
+		IntermediaryAddress: delAddr.String(),
+	})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryBondTokens() {
+	valAddr := suite.validator.GetOperator()
+
+	res, err := suite.queryClient.BondTokenWeights(gocontext.Background(), &types.QueryBondTokenWeightsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Contains(res.BondTokenWeights, types.BondTokenWeightInfo{BondDenom: bondDenom, BondTokenWeight: bondWeight, BaseDenom: bondDenom})
+
+	weightRes, err := suite.queryClient.BondTokenWeight(gocontext.Background(), &types.QueryBondTokenWeightRequest{BondDenom: bondDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(bondWeight, weightRes.BondTokenWeight.BondTokenWeight)
+	suite.Require().False(weightRes.Sunsetting)
+
+	_, err = suite.queryClient.BondTokenWeight(gocontext.Background(), &types.QueryBondTokenWeightRequest{BondDenom: "unknown"})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+
+	denomRes, err := suite.queryClient.ValidatorBondDenom(gocontext.Background(), &types.QueryValidatorBondDenomRequest{
+		ValidatorAddress: valAddr.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(bondDenom, denomRes.BondDenom)
+
+	_, err = suite.queryClient.ValidatorBondDenom(gocontext.Background(), &types.QueryValidatorBondDenomRequest{
+		ValidatorAddress: sdk.ValAddress(suite.fundedAccount(nil)).String(),
+	})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryMultiStakingDelegations() {
+	suite.disableInflation()
+	valAddr := suite.validator.GetOperator()
+	otherValAddr := suite.createValidator(sdk.NewInt64Coin(bondDenom, 1000))
+	sdkBondDenom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+
+	delegated := sdk.NewInt64Coin(bondDenom, 1000)
+	delAddr := suite.fundedAccount(sdk.NewCoins(delegated.Add(delegated)))
+	for _, addr := range []sdk.ValAddress{valAddr, otherValAddr} {
+		_, err := suite.msgServer.Delegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgDelegate(delAddr, addr, delegated))
+		suite.Require().NoError(err)
+	}
+	undelegated := sdk.NewInt64Coin(bondDenom, 400)
+	ubdRes, err := suite.msgServer.Undelegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgUndelegate(delAddr, valAddr, undelegated))
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.MultiStakingDelegation(gocontext.Background(), &types.QueryMultiStakingDelegationRequest{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.IntermediaryAccount(delAddr, valAddr).String(), res.Delegation.IntermediaryAddress)
+	suite.Require().Equal(sdk.NewInt64Coin(bondDenom, 600), res.Delegation.BondTokens)
+	suite.Require().Equal(sdk.NewInt64Coin(sdkBondDenom, 300), res.Delegation.SDKBondTokens)
+	suite.Require().Equal(sdk.NewInt64Coin(sdkBondDenom, 300), res.Delegation.Balance)
+	suite.Require().True(res.Delegation.Shares.IsPositive())
+
+	_, err = suite.queryClient.MultiStakingDelegation(gocontext.Background(), &types.QueryMultiStakingDelegationRequest{
+		DelegatorAddress: suite.fundedAccount(nil).String(),
+		ValidatorAddress: valAddr.String(),
+	})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+
+	delsRes, err := suite.queryClient.DelegatorMultiStakingDelegations(gocontext.Background(), &types.QueryDelegatorMultiStakingDelegationsRequest{
+		DelegatorAddress: delAddr.String(),
+		Pagination:       &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(delsRes.Delegations, 1)
+	suite.Require().Equal(uint64(2), delsRes.Pagination.Total)
+
+	delsRes, err = suite.queryClient.DelegatorMultiStakingDelegations(gocontext.Background(), &types.QueryDelegatorMultiStakingDelegationsRequest{
+		DelegatorAddress: delAddr.String(),
+		Pagination:       &query.PageRequest{Key: delsRes.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(delsRes.Delegations, 1)
+	suite.Require().Nil(delsRes.Pagination.NextKey)
+
+	ubdsRes, err := suite.queryClient.MultiStakingUnbondings(gocontext.Background(), &types.QueryMultiStakingUnbondingsRequest{
+		DelegatorAddress: delAddr.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(ubdsRes.Unbondings, 1)
+	unbonding := ubdsRes.Unbondings[0]
+	suite.Require().Equal(valAddr.String(), unbonding.ValidatorAddress)
+	suite.Require().Equal(suite.ctx.BlockHeight(), unbonding.CreationHeight)
+	suite.Require().Equal(ubdRes.CompletionTime, unbonding.CompletionTime)
+	suite.Require().Equal(undelegated, unbonding.BondTokens)
+	suite.Require().Equal(sdk.NewInt64Coin(sdkBondDenom, 200), unbonding.SDKBondTokens)
+	suite.Require().Equal(sdk.NewInt64Coin(sdkBondDenom, 200), unbonding.Balance)
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// GetBondDenomTrace returns the denom trace of an IBC bond denom
+func (k Keeper) GetBondDenomTrace(ctx sdk.Context, denom string) (ibctransfertypes.DenomTrace, bool) {
+	if !types.IsIBCDenom(denom) {
+		return ibctransfertypes.DenomTrace{}, false
+	}
+
+	hash, err := ibctransfertypes.ParseHexHash(denom[len(ibctransfertypes.DenomPrefix+"/"):])
+	if err != nil {
+		return ibctransfertypes.DenomTrace{}, false
+	}
+	return k.transferKeeper.GetDenomTrace(ctx, hash)
+}
+
+// BaseDenom returns the base denom of an IBC bond denom resolved through its
+// denom trace, or the denom itself if it is not an IBC denom
+func (k Keeper) BaseDenom(ctx sdk.Context, denom string) string {
+	if trace, found := k.GetBondDenomTrace(ctx, denom); found {
+		return trace.BaseDenom
+	}
+	return denom
+}
+
+// ValidateIBCBondDenom checks that an IBC bond denom has a denom trace. If a
+// source channel is given, the bond denom must have been received over it
+// directly from the chain it is native to, so that the same base denom sent
+// over another path is not accepted.
+func (k Keeper) ValidateIBCBondDenom(ctx sdk.Context, denom, sourceChannel string) error {
+	if !types.IsIBCDenom(denom) {
+		if sourceChannel != "" {
+			return sdkerrors.Wrapf(types.ErrInvalidSourceChannel, "%s is not an IBC denom", denom)
+		}
+		return nil
+	}
+
+	trace, found := k.GetBondDenomTrace(ctx, denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrDenomTraceNotFound, "%s", denom)
+	}
+
+	if sourceChannel != "" {
+		expectedPath := ibctransfertypes.PortID + "/" + sourceChannel
+		if trace.Path != expectedPath {
+			return sdkerrors.Wrapf(types.ErrInvalidSourceChannel, "%s was received over %s, expected %s", denom, trace.Path, expectedPath)
+		}
+	}
+	return nil
+}
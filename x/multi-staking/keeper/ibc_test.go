@@ -0,0 +1,185 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/notional-labs/multi-staking-module/testing/simapp"
+	multistaking "github.com/notional-labs/multi-staking-module/x/multi-staking"
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/keeper"
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// IBCTestSuite runs two simapp chains connected by two transfer channels, over
+// which chainB sends its native bond tokens to chainA
+type IBCTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chainA      *ibctesting.TestChain
+	chainB      *ibctesting.TestChain
+	path        *ibctesting.Path
+	otherPath   *ibctesting.Path
+}
+
+func (suite *IBCTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = simapp.SetupTestingApp
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = suite.newTransferPath()
+	suite.otherPath = suite.newTransferPath()
+}
+
+func (suite *IBCTestSuite) newTransferPath() *ibctesting.Path {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		endpoint.ChannelConfig.PortID = ibctesting.TransferPort
+		endpoint.ChannelConfig.Version = ibctransfertypes.Version
+	}
+	suite.coordinator.Setup(path)
+	return path
+}
+
+func (suite *IBCTestSuite) app(chain *ibctesting.TestChain) *simapp.SimApp {
+	return chain.App.(*simapp.SimApp)
+}
+
+// transferToChainA sends bond tokens native to chainB over the path to the
+// chainA sender and returns their IBC denom on chainA
+func (suite *IBCTestSuite) transferToChainA(path *ibctesting.Path, token sdk.Coin) string {
+	sender := suite.chainB.SenderAccount.GetAddress()
+	suite.Require().NoError(simapp.FundAccount(suite.app(suite.chainB), suite.chainB.GetContext(), sender, sdk.NewCoins(token)))
+
+	msg := ibctransfertypes.NewMsgTransfer(
+		path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, token,
+		sender.String(), suite.chainA.SenderAccount.GetAddress().String(),
+		clienttypes.NewHeight(1, 110), 0, "",
+	)
+	res, err := suite.chainB.SendMsgs(msg)
+	suite.Require().NoError(err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	return ibctransfertypes.ParseDenomTrace(
+		ibctransfertypes.GetPrefixedDenom(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, token.Denom),
+	).IBCDenom()
+}
+
+func (suite *IBCTestSuite) TestAddIBCBondDenomProposal() {
+	denom := suite.transferToChainA(suite.path, sdk.NewInt64Coin(bondDenom, 1000))
+	otherDenom := suite.transferToChainA(suite.otherPath, sdk.NewInt64Coin(bondDenom, 1000))
+	unknownDenom := ibctransfertypes.ParseDenomTrace(
+		ibctransfertypes.GetPrefixedDenom(ibctransfertypes.PortID, "channel-5", bondDenom),
+	).IBCDenom()
+	channel := suite.path.EndpointA.ChannelID
+
+	testCases := []struct {
+		name          string
+		denom         string
+		sourceChannel string
+		expectErr     error
+	}{
+		{
+			name:  "received ibc denom",
+			denom: denom,
+		},
+		{
+			name:          "ibc denom received over the source channel",
+			denom:         denom,
+			sourceChannel: channel,
+		},
+		{
+			name:          "same base denom received over another channel",
+			denom:         otherDenom,
+			sourceChannel: channel,
+			expectErr:     types.ErrInvalidSourceChannel,
+		},
+		{
+			name:      "ibc denom without denom trace",
+			denom:     unknownDenom,
+			expectErr: types.ErrDenomTraceNotFound,
+		},
+		{
+			name:          "native denom with source channel",
+			denom:         "uatom",
+			sourceChannel: channel,
+			expectErr:     types.ErrInvalidSourceChannel,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.chainA.GetContext().CacheContext()
+			msKeeper := suite.app(suite.chainA).MultiStakingKeeper
+
+			handler := multistaking.NewProposalHandler(msKeeper)
+			err := handler(ctx, types.NewAddBondDenomProposal("title", "description", tc.denom, bondWeight, tc.sourceChannel))
+			if tc.expectErr != nil {
+				suite.Require().ErrorIs(err, tc.expectErr)
+				suite.Require().False(msKeeper.IsBondDenom(ctx, tc.denom))
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().True(msKeeper.IsBondDenom(ctx, tc.denom))
+		})
+	}
+}
+
+func (suite *IBCTestSuite) TestIBCBondDenomQueries() {
+	token := sdk.NewInt64Coin(bondDenom, 1000)
+	denom := suite.transferToChainA(suite.path, token)
+
+	ctx := suite.chainA.GetContext()
+	app := suite.app(suite.chainA)
+	msKeeper := app.MultiStakingKeeper
+	handler := multistaking.NewProposalHandler(msKeeper)
+	suite.Require().NoError(handler(ctx, types.NewAddBondDenomProposal("title", "description", denom, bondWeight, suite.path.EndpointA.ChannelID)))
+
+	// the ibc bond tokens are self-delegated to a validator pinned to them like
+	// native ones
+	delAddr := suite.chainA.SenderAccount.GetAddress()
+	valAddr := sdk.ValAddress(delAddr)
+	ibcToken := sdk.NewCoin(denom, token.Amount)
+	msg, err := types.NewMsgCreateValidator(
+		valAddr, ed25519.GenPrivKey().PubKey(), ibcToken,
+		stakingtypes.NewDescription("moniker", "", "", "", ""),
+		stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		sdk.OneInt(), denom,
+	)
+	suite.Require().NoError(err)
+	_, err = keeper.NewMsgServerImpl(msKeeper).CreateValidator(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().NoError(err)
+	bondTokens, found := msKeeper.GetDVPairBondTokens(ctx, delAddr, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(ibcToken, bondTokens)
+	invariantMsg, broken := keeper.AllInvariants(msKeeper)(ctx)
+	suite.Require().False(broken, invariantMsg)
+
+	querier := keeper.Querier{Keeper: msKeeper}
+	weightRes, err := querier.BondTokenWeight(sdk.WrapSDKContext(ctx), &types.QueryBondTokenWeightRequest{BondDenom: denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(bondDenom, weightRes.BondTokenWeight.BaseDenom)
+
+	weightsRes, err := querier.BondTokenWeights(sdk.WrapSDKContext(ctx), &types.QueryBondTokenWeightsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Contains(weightsRes.BondTokenWeights, types.BondTokenWeightInfo{BondDenom: denom, BondTokenWeight: bondWeight, BaseDenom: bondDenom})
+
+	valRes, err := querier.ValidatorBondDenom(sdk.WrapSDKContext(ctx), &types.QueryValidatorBondDenomRequest{ValidatorAddress: valAddr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(denom, valRes.BondDenom)
+	suite.Require().Equal(bondDenom, valRes.BaseDenom)
+}
+
+func TestIBCTestSuite(t *testing.T) {
+	suite.Run(t, new(IBCTestSuite))
+}
@@ -0,0 +1,390 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// RegisterInvariants registers all multi-staking invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "sdkbond-tokens", SDKBondTokensInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "sdkbond-backing", SDKBondBackingInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "intermediary-bond-tokens", IntermediaryBondTokensInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "validator-bond-denoms", ValidatorBondDenomsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "bond-denoms", BondDenomsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "bonded-tokens", BondedTokensInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "bond-denom-uses", BondDenomUsesInvariant(k))
+}
+
+// AllInvariants runs all invariants of the multi-staking module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := SDKBondTokensInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = SDKBondBackingInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = IntermediaryBondTokensInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = ValidatorBondDenomsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = BondDenomsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = BondedTokensInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return BondDenomUsesInvariant(k)(ctx)
+	}
+}
+
+// SDKBondTokensInvariant checks that the sdkbond tokens of every DV pair are
+// its bond tokens times the weight of their denom, so that the total sdkbond
+// tokens of a bond denom is the sum of the bond tokens times the weight. The
+// DV pairs of a bond denom being reweighted are skipped.
+func SDKBondTokensInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		totalSDKBond := make(map[string]math.Int)
+		totalExpected := make(map[string]math.Int)
+
+		k.IterateDVPairBondTokens(ctx, func(delAddr sdk.AccAddress, valAddr sdk.ValAddress, bondTokens sdk.Coin) bool {
+			denom := bondTokens.Denom
+			if _, found := k.GetReweighting(ctx, denom); found {
+				return false
+			}
+
+			weight, found := k.GetBondTokenWeight(ctx, denom)
+			if !found {
+				broken = true
+				msg += fmt.Sprintf("\tDV pair (%s, %s) has bond tokens %s of no bond denom\n", delAddr, valAddr, bondTokens)
+				return false
+			}
+
+			expected := weight.MulInt(bondTokens.Amount).TruncateInt()
+			sdkBondAmount := math.ZeroInt()
+			if sdkBondTokens, found := k.GetDVPairSDKBondTokens(ctx, delAddr, valAddr); found {
+				sdkBondAmount = sdkBondTokens.Amount
+			}
+			if !sdkBondAmount.Equal(expected) {
+				broken = true
+				msg += fmt.Sprintf("\tDV pair (%s, %s) has %s sdkbond tokens for bond tokens %s, expected %s\n",
+					delAddr, valAddr, sdkBondAmount, bondTokens, expected)
+			}
+
+			if _, ok := totalSDKBond[denom]; !ok {
+				totalSDKBond[denom] = math.ZeroInt()
+				totalExpected[denom] = math.ZeroInt()
+			}
+			totalSDKBond[denom] = totalSDKBond[denom].Add(sdkBondAmount)
+			totalExpected[denom] = totalExpected[denom].Add(expected)
+			return false
+		})
+
+		for _, denom := range sortedKeys(totalSDKBond) {
+			msg += fmt.Sprintf("\t%s: sdkbond tokens %s, sum of bond tokens times weight %s\n",
+				denom, totalSDKBond[denom], totalExpected[denom])
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "sdkbond tokens", msg), broken
+	}
+}
+
+// SDKBondBackingInvariant checks that the sdkbond tokens issued by the module
+// and not retired yet are the DVPairSDKBondTokens plus the sdkbond tokens of
+// the DVPairUnbondingTokens, so that no record of them is lost or left behind.
+// It also checks that they back the sdk delegations and unbonding delegations
+// of the intermediary accounts: the sdkbond tokens delegated and unbonding
+// from the intermediary accounts are at most the issued ones, and lower once
+// sdk delegations or unbonding entries are slashed. Only the totals are
+// compared there, as the staking module leaves the rounding dust of an
+// undelegation to the remaining delegators of the validator, so the sdk
+// delegation of a single intermediary account may exceed its minted sdkbond
+// tokens by the dust of the others.
+func SDKBondBackingInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		totalRecorded, totalBacking := math.ZeroInt(), math.ZeroInt()
+		k.IterateDVPairSDKBondTokens(ctx, func(_ sdk.AccAddress, _ sdk.ValAddress, sdkBondTokens sdk.Coin) bool {
+			totalRecorded = totalRecorded.Add(sdkBondTokens.Amount)
+			return false
+		})
+		k.IterateDVPairUnbondingTokens(ctx, func(_ sdk.AccAddress, _ sdk.ValAddress, _ time.Time, tokens types.UnbondingTokens) bool {
+			totalRecorded = totalRecorded.Add(tokens.SDKBondTokens.Amount)
+			return false
+		})
+
+		k.IterateIntermediaryAccountDelegators(ctx, func(intermediaryAccount, _ sdk.AccAddress) bool {
+			for _, delegation := range k.stakingKeeper.GetAllDelegatorDelegations(ctx, intermediaryAccount) {
+				if validator, found := k.stakingKeeper.GetValidator(ctx, delegation.GetValidatorAddr()); found {
+					totalBacking = totalBacking.Add(validator.TokensFromShares(delegation.Shares).TruncateInt())
+				}
+			}
+			for _, ubd := range k.stakingKeeper.GetAllUnbondingDelegations(ctx, intermediaryAccount) {
+				for _, entry := range ubd.Entries {
+					totalBacking = totalBacking.Add(entry.Balance)
+				}
+			}
+			return false
+		})
+
+		issued := k.GetIssuedSDKBondTokens(ctx)
+		broken := !issued.Equal(totalRecorded) || totalBacking.GT(issued)
+		msg := fmt.Sprintf("\tsdkbond tokens issued %s, recorded %s, delegated and unbonding %s\n", issued, totalRecorded, totalBacking)
+
+		return sdk.FormatInvariant(types.ModuleName, "sdkbond backing", msg), broken
+	}
+}
+
+// IntermediaryBondTokensInvariant checks that every intermediary account holds
+// the bond tokens locked for its DV pair, its DVPairBondToken plus the bond
+// tokens of its DVPairUnbondingTokens. The balance can only be higher: users
+// cannot send coins to an intermediary account, but the modules paying with
+// the bank keeper itself still can, e.g. the distribution module paying the
+// rewards of a delegator whose withdraw address is an intermediary account.
+func IntermediaryBondTokensInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		locked := make(map[string]sdk.Coins)
+		addLocked := func(delAddr sdk.AccAddress, valAddr sdk.ValAddress, bondTokens sdk.Coin) {
+			key := string(types.IntermediaryAccount(delAddr, valAddr))
+			locked[key] = locked[key].Add(bondTokens)
+		}
+		k.IterateDVPairBondTokens(ctx, func(delAddr sdk.AccAddress, valAddr sdk.ValAddress, bondTokens sdk.Coin) bool {
+			addLocked(delAddr, valAddr, bondTokens)
+			return false
+		})
+		k.IterateDVPairUnbondingTokens(ctx, func(delAddr sdk.AccAddress, valAddr sdk.ValAddress, _ time.Time, tokens types.UnbondingTokens) bool {
+			addLocked(delAddr, valAddr, tokens.BondTokens)
+			return false
+		})
+
+		keys := make([]string, 0, len(locked))
+		for key := range locked {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			intermediaryAccount := sdk.AccAddress(key)
+			for _, coin := range locked[key] {
+				balance := k.bankKeeper.GetBalance(ctx, intermediaryAccount, coin.Denom)
+				if balance.IsLT(coin) {
+					broken = true
+					msg += fmt.Sprintf("\tintermediary account %s holds %s, but %s are locked for it\n",
+						intermediaryAccount, balance, coin)
+				}
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "intermediary bond tokens", msg), broken
+	}
+}
+
+// ValidatorBondDenomsInvariant checks that every validator with tokens has a
+// ValidatorBondDenom, and that every multi-staking stake of a validator,
+// bonded or unbonding, is in its bond denom.
+func ValidatorBondDenomsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		k.stakingKeeper.IterateValidators(ctx, func(_ int64, validator stakingtypes.ValidatorI) bool {
+			if !validator.GetTokens().IsPositive() {
+				return false
+			}
+			if _, found := k.GetValidatorBondDenom(ctx, validator.GetOperator()); !found {
+				broken = true
+				msg += fmt.Sprintf("\tvalidator %s has %s tokens but no bond denom\n", validator.GetOperator(), validator.GetTokens())
+			}
+			return false
+		})
+
+		checkDenom := func(delAddr sdk.AccAddress, valAddr sdk.ValAddress, bondTokens sdk.Coin) {
+			denom, found := k.GetValidatorBondDenom(ctx, valAddr)
+			switch {
+			case !found:
+				broken = true
+				msg += fmt.Sprintf("\tvalidator %s has stake %s of %s but no bond denom\n", valAddr, bondTokens, delAddr)
+			case denom != bondTokens.Denom:
+				broken = true
+				msg += fmt.Sprintf("\tvalidator %s of %s has stake %s of %s\n", valAddr, denom, bondTokens, delAddr)
+			}
+		}
+		k.IterateDVPairBondTokens(ctx, func(delAddr sdk.AccAddress, valAddr sdk.ValAddress, bondTokens sdk.Coin) bool {
+			checkDenom(delAddr, valAddr, bondTokens)
+			return false
+		})
+		k.IterateDVPairUnbondingTokens(ctx, func(delAddr sdk.AccAddress, valAddr sdk.ValAddress, _ time.Time, tokens types.UnbondingTokens) bool {
+			// the unbonding tokens of a sdkbond reweighting have no bond tokens
+			if !tokens.BondTokens.IsZero() {
+				checkDenom(delAddr, valAddr, tokens.BondTokens)
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "validator bond denoms", msg), broken
+	}
+}
+
+// BondDenomsInvariant checks that every bond denom in use, by a validator or
+// by bonded or unbonding bond tokens, has a BondTokenWeight.
+func BondDenomsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		inUse := make(map[string]bool)
+		k.IterateValidatorBondDenoms(ctx, func(_ sdk.ValAddress, denom string) bool {
+			inUse[denom] = true
+			return false
+		})
+		k.IterateDVPairBondTokens(ctx, func(_ sdk.AccAddress, _ sdk.ValAddress, bondTokens sdk.Coin) bool {
+			inUse[bondTokens.Denom] = true
+			return false
+		})
+		k.IterateDVPairUnbondingTokens(ctx, func(_ sdk.AccAddress, _ sdk.ValAddress, _ time.Time, tokens types.UnbondingTokens) bool {
+			if !tokens.BondTokens.IsZero() {
+				inUse[tokens.BondTokens.Denom] = true
+			}
+			return false
+		})
+
+		denoms := make([]string, 0, len(inUse))
+		for denom := range inUse {
+			denoms = append(denoms, denom)
+		}
+		sort.Strings(denoms)
+
+		for _, denom := range denoms {
+			if !k.IsBondDenom(ctx, denom) {
+				broken = true
+				msg += fmt.Sprintf("\tbond denom %s is in use but has no weight\n", denom)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "bond denoms", msg), broken
+	}
+}
+
+// BondedTokensInvariant checks that the bonded tokens of every bond denom,
+// which the staking caps are enforced against, are the sum of the
+// DVPairBondTokens of the denom.
+func BondedTokensInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		bonded := make(map[string]math.Int)
+		expected := make(map[string]math.Int)
+		k.IterateBondedTokens(ctx, func(denom string, amount math.Int) bool {
+			bonded[denom] = amount
+			expected[denom] = math.ZeroInt()
+			return false
+		})
+		k.IterateDVPairBondTokens(ctx, func(_ sdk.AccAddress, _ sdk.ValAddress, bondTokens sdk.Coin) bool {
+			denom := bondTokens.Denom
+			if _, ok := expected[denom]; !ok {
+				bonded[denom] = math.ZeroInt()
+				expected[denom] = math.ZeroInt()
+			}
+			expected[denom] = expected[denom].Add(bondTokens.Amount)
+			return false
+		})
+
+		for _, denom := range sortedKeys(expected) {
+			if !bonded[denom].Equal(expected[denom]) {
+				broken = true
+				msg += fmt.Sprintf("\t%s: bonded tokens %s, sum of DV pair bond tokens %s\n",
+					denom, bonded[denom], expected[denom])
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "bonded tokens", msg), broken
+	}
+}
+
+// BondDenomUsesInvariant checks that the uses of every bond denom, which a
+// sunsetting bond denom is removed on, are the number of its DVPairBondTokens
+// and DVPairUnbondingTokens records.
+func BondDenomUsesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		uses := make(map[string]uint64)
+		expected := make(map[string]uint64)
+		k.IterateBondDenomUses(ctx, func(denom string, count uint64) bool {
+			uses[denom] = count
+			return false
+		})
+		k.IterateDVPairBondTokens(ctx, func(_ sdk.AccAddress, _ sdk.ValAddress, bondTokens sdk.Coin) bool {
+			expected[bondTokens.Denom]++
+			return false
+		})
+		k.IterateDVPairUnbondingTokens(ctx, func(_ sdk.AccAddress, _ sdk.ValAddress, _ time.Time, tokens types.UnbondingTokens) bool {
+			expected[tokens.BondTokens.Denom]++
+			return false
+		})
+
+		for denom := range uses {
+			if _, ok := expected[denom]; !ok {
+				expected[denom] = 0
+			}
+		}
+		for _, denom := range sortedKeys(expected) {
+			if uses[denom] != expected[denom] {
+				broken = true
+				msg += fmt.Sprintf("\t%s: uses %d, DV pair records %d\n", denom, uses[denom], expected[denom])
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "bond denom uses", msg), broken
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/keeper"
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	testCases := []struct {
+		name      string
+		invariant func(keeper.Keeper) sdk.Invariant
+		malleate  func(delAddr sdk.AccAddress, valAddr sdk.ValAddress)
+	}{
+		{
+			name:      "sdkbond tokens delegated without a record",
+			invariant: keeper.SDKBondBackingInvariant,
+			malleate: func(delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+				suite.msKeeper.DeleteDVPairSDKBondTokens(suite.ctx, delAddr, valAddr)
+			},
+		},
+		{
+			name:      "sdkbond tokens recorded without being issued",
+			invariant: keeper.SDKBondBackingInvariant,
+			malleate: func(delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+				suite.msKeeper.SetDVPairSDKBondTokens(suite.ctx, delAddr, valAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 2000))
+			},
+		},
+		{
+			name:      "unbonding sdkbond tokens without a record",
+			invariant: keeper.SDKBondBackingInvariant,
+			malleate: func(delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+				completionTime, err := suite.msKeeper.Undelegate(suite.ctx, delAddr, valAddr, sdk.NewInt64Coin(bondDenom, 1000))
+				suite.Require().NoError(err)
+				suite.msKeeper.DeleteDVPairUnbondingTokens(suite.ctx, delAddr, valAddr, completionTime)
+			},
+		},
+		{
+			name:      "bond tokens locked without a balance",
+			invariant: keeper.IntermediaryBondTokensInvariant,
+			malleate: func(delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+				suite.msKeeper.SetDVPairBondTokens(suite.ctx, delAddr, valAddr, sdk.NewInt64Coin(bondDenom, 1011))
+			},
+		},
+		{
+			name:      "validator with stake and no bond denom",
+			invariant: keeper.ValidatorBondDenomsInvariant,
+			malleate: func(_ sdk.AccAddress, valAddr sdk.ValAddress) {
+				suite.msKeeper.DeleteValidatorBondDenom(suite.ctx, valAddr)
+			},
+		},
+		{
+			name:      "validator with tokens and no bond denom",
+			invariant: keeper.ValidatorBondDenomsInvariant,
+			malleate: func(delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+				// no multi-staking stake is left to point at the validator
+				suite.msKeeper.DeleteDVPairBondTokens(suite.ctx, delAddr, valAddr)
+				suite.msKeeper.DeleteValidatorBondDenom(suite.ctx, valAddr)
+			},
+		},
+		{
+			name:      "validator with stake of another bond denom",
+			invariant: keeper.ValidatorBondDenomsInvariant,
+			malleate: func(_ sdk.AccAddress, valAddr sdk.ValAddress) {
+				suite.msKeeper.SetValidatorBondDenom(suite.ctx, valAddr, convertDenom)
+			},
+		},
+		{
+			name:      "bond denom in use without a weight",
+			invariant: keeper.BondDenomsInvariant,
+			malleate: func(_ sdk.AccAddress, _ sdk.ValAddress) {
+				suite.msKeeper.DeleteBondTokenWeight(suite.ctx, bondDenom)
+			},
+		},
+		{
+			name:      "bonded tokens without bond tokens",
+			invariant: keeper.BondedTokensInvariant,
+			malleate: func(_ sdk.AccAddress, _ sdk.ValAddress) {
+				store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+				store.Delete(types.GetBondedTokensKey(bondDenom))
+			},
+		},
+		{
+			name:      "bond denom uses without DV pair records",
+			invariant: keeper.BondDenomUsesInvariant,
+			malleate: func(_ sdk.AccAddress, _ sdk.ValAddress) {
+				store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+				store.Delete(types.GetBondDenomUsesKey(bondDenom))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			valAddr := suite.validator.GetOperator()
+			delAddr := suite.delegateAll(valAddr, 1000)[0]
+
+			_, broken := tc.invariant(suite.msKeeper)(suite.ctx)
+			suite.Require().False(broken)
+
+			// the intermediary account can receive more coins than it locks
+			suite.Require().NoError(suite.app.BankKeeper.SendCoins(
+				suite.ctx, suite.fundedAccount(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10))),
+				suite.msKeeper.IntermediaryAccountFromDelegator(delAddr, valAddr), sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10)),
+			))
+			suite.requireInvariant()
+
+			tc.malleate(delAddr, valAddr)
+			msg, broken := tc.invariant(suite.msKeeper)(suite.ctx)
+			suite.Require().True(broken, msg)
+		})
+	}
+}
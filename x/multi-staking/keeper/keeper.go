@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// Keeper of the multi-staking store
+type Keeper struct {
+	storeKey       storetypes.StoreKey
+	memKey         storetypes.StoreKey
+	cdc            codec.BinaryCodec
+	paramstore     paramtypes.Subspace
+	accountKeeper  types.AccountKeeper
+	bankKeeper     types.BankKeeper
+	distrKeeper    types.DistributionKeeper
+	govKeeper      types.GovKeeper
+	stakingKeeper  stakingkeeper.Keeper
+	slashingKeeper types.SlashingKeeper
+	transferKeeper types.TransferKeeper
+	weightProvider types.WeightProvider
+}
+
+// NewKeeper creates a new multi-staking Keeper instance
+//
+// NOTE: the staking keeper must already have its hooks set, since the
+// multi-staking keeper wraps it by value. The transfer keeper is only used once
+// blocks are processed, so it may be a pointer to the app transfer keeper that
+// is created later on.
+func NewKeeper(
+	cdc codec.BinaryCodec, key, memKey storetypes.StoreKey, ps paramtypes.Subspace,
+	ak types.AccountKeeper, bk types.BankKeeper, dk types.DistributionKeeper, sk stakingkeeper.Keeper,
+	slk types.SlashingKeeper, tk types.TransferKeeper,
+) Keeper {
+	// set KeyTable if it has not already been set
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:       key,
+		memKey:         memKey,
+		cdc:            cdc,
+		paramstore:     ps,
+		accountKeeper:  ak,
+		bankKeeper:     bk,
+		distrKeeper:    dk,
+		stakingKeeper:  sk,
+		slashingKeeper: slk,
+		transferKeeper: tk,
+	}
+}
+
+// SetGovKeeper sets the gov keeper used to vote with the intermediary
+// accounts. It is set once the gov keeper exists, since the gov router needs
+// the multi-staking proposal handler.
+func (k *Keeper) SetGovKeeper(gk types.GovKeeper) *Keeper {
+	if k.govKeeper != nil {
+		panic("cannot set multi-staking gov keeper twice")
+	}
+
+	k.govKeeper = gk
+	return k
+}
+
+// SetWeightProvider sets the price source the weights of the bond denoms with
+// weight bounds are recomputed from every epoch. Without it, the weights only
+// change through governance.
+func (k *Keeper) SetWeightProvider(wp types.WeightProvider) *Keeper {
+	if k.weightProvider != nil {
+		panic("cannot set multi-staking weight provider twice")
+	}
+
+	k.weightProvider = wp
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// IntermediaryAccountFromDelegator returns the intermediary account of a
+// (delegator, validator) pair. It is derived as a module account address from
+// the length prefixed delegator and validator addresses.
+func (k Keeper) IntermediaryAccountFromDelegator(delAddr sdk.AccAddress, valAddr sdk.ValAddress) sdk.AccAddress {
+	return types.IntermediaryAccount(delAddr, valAddr)
+}
+
+// DelegatorFromIntermediaryAccount returns the delegator an intermediary
+// account acts for. The intermediary account must have delegated once.
+func (k Keeper) DelegatorFromIntermediaryAccount(ctx sdk.Context, intermediaryAccount sdk.AccAddress) (sdk.AccAddress, bool) {
+	return k.GetIntermediaryAccountDelegator(ctx, intermediaryAccount)
+}
+
+// IsBondDenom returns true if the denom is an accepted bond token
+func (k Keeper) IsBondDenom(ctx sdk.Context, denom string) bool {
+	_, found := k.GetBondTokenWeight(ctx, denom)
+	return found
+}
+
+// IsBondDenomSunsetting returns true if the bond denom is being removed
+func (k Keeper) IsBondDenomSunsetting(ctx sdk.Context, denom string) bool {
+	_, found := k.GetBondDenomSunsetHeight(ctx, denom)
+	return found
+}
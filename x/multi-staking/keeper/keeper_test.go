@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/notional-labs/multi-staking-module/testing/simapp"
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/keeper"
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+const bondDenom = "ulp"
+
+var bondWeight = sdk.MustNewDecFromStr("0.5")
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	msKeeper    keeper.Keeper
+	msgServer   types.MsgServer
+	queryClient types.QueryClient
+	validator   stakingtypes.Validator
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = simapp.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{})
+	suite.msKeeper = suite.app.MultiStakingKeeper
+	suite.msgServer = keeper.NewMsgServerImpl(suite.msKeeper)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, keeper.Querier{Keeper: suite.msKeeper})
+	suite.queryClient = types.NewQueryClient(queryHelper)
+
+	// pin the genesis validator to the test bond denom
+	suite.msKeeper.SetBondTokenWeight(suite.ctx, bondDenom, bondWeight)
+	suite.validator = suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+	suite.msKeeper.SetValidatorBondDenom(suite.ctx, suite.validator.GetOperator(), bondDenom)
+}
+
+// fundedAccount returns a new account funded with the given coins
+func (suite *KeeperTestSuite) fundedAccount(coins sdk.Coins) sdk.AccAddress {
+	_, _, addr := testdata.KeyTestPubAddr()
+	suite.Require().NoError(simapp.FundAccount(suite.app, suite.ctx, addr, coins))
+	return addr
+}
+
+// disableInflation stops the mint module from minting sdkbond tokens, so that
+// the sdkbond supply only changes through the multi-staking module
+func (suite *KeeperTestSuite) disableInflation() {
+	params := suite.app.MintKeeper.GetParams(suite.ctx)
+	params.InflationMin = sdk.ZeroDec()
+	params.InflationMax = sdk.ZeroDec()
+	suite.app.MintKeeper.SetParams(suite.ctx, params)
+	suite.app.MintKeeper.SetMinter(suite.ctx, minttypes.InitialMinter(sdk.ZeroDec()))
+}
+
+// nextBlock runs the begin and end blockers of all modules for a new block at
+// the given time and returns the events emitted by them
+func (suite *KeeperTestSuite) nextBlock(blockTime time.Time) []abci.Event {
+	suite.ctx = suite.ctx.
+		WithBlockHeight(suite.ctx.BlockHeight() + 1).
+		WithBlockTime(blockTime)
+	resBeginBlock := suite.app.BeginBlocker(suite.ctx, abci.RequestBeginBlock{})
+	resEndBlock := suite.app.EndBlocker(suite.ctx, abci.RequestEndBlock{})
+	return append(resBeginBlock.Events, resEndBlock.Events...)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
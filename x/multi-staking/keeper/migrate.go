@@ -0,0 +1,261 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// MigrateStakingDelegations moves a chain running the plain staking module to
+// multi-staking. The staking bond denom becomes a bond denom of weight one and
+// the staking module is switched to the given new sdkbond denom, so that the
+// existing stake is locked as bond tokens instead of being minted twice. Every
+// validator without a bond denom is pinned to the former staking bond denom,
+// and every sdk delegation, unbonding delegation and redelegation is taken
+// over by the intermediary account of its DV pair.
+//
+// The staking pools keep their balances, in sdkbond tokens minted for them,
+// while their former stake is moved to the intermediary accounts as locked
+// bond tokens. The shares of the sdk delegations are moved as they are, so the
+// voting power of the validators does not change, and so are the distribution
+// starting infos, so the pending rewards of the delegators do not change
+// either. The intermediary accounts pay the rewards to the withdraw address of
+// their delegator. Vesting accounts keep tracking the stake they delegated as
+// delegated, until the bond tokens are unlocked to them.
+//
+// Sdk delegations worth less than one token are left untouched. The stake
+// they and the rounding of the other delegations leave in the staking pools is
+// sent to the unbonding remainder recipient, or burned if there is none.
+func (k Keeper) MigrateStakingDelegations(ctx sdk.Context, sdkBondDenom string) error {
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	if err := sdk.ValidateDenom(sdkBondDenom); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidBondDenom, err.Error())
+	}
+	if sdkBondDenom == bondDenom || k.IsBondDenom(ctx, sdkBondDenom) {
+		return sdkerrors.Wrapf(types.ErrInvalidBondDenom, "sdkbond denom %s is in use", sdkBondDenom)
+	}
+
+	pools := []string{stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName}
+	if err := k.mintPoolsSDKBond(ctx, pools, bondDenom, sdkBondDenom); err != nil {
+		return err
+	}
+	params := k.stakingKeeper.GetParams(ctx)
+	params.BondDenom = sdkBondDenom
+	k.stakingKeeper.SetParams(ctx, params)
+
+	k.SetBondTokenWeight(ctx, bondDenom, sdk.OneDec())
+	for _, validator := range k.stakingKeeper.GetAllValidators(ctx) {
+		if _, found := k.GetValidatorBondDenom(ctx, validator.GetOperator()); !found {
+			k.SetValidatorBondDenom(ctx, validator.GetOperator(), bondDenom)
+		}
+	}
+
+	for _, delegation := range k.stakingKeeper.GetAllDelegations(ctx) {
+		if err := k.migrateStakingDelegation(ctx, bondDenom, delegation); err != nil {
+			return err
+		}
+	}
+
+	var ubds []stakingtypes.UnbondingDelegation
+	k.stakingKeeper.IterateUnbondingDelegations(ctx, func(_ int64, ubd stakingtypes.UnbondingDelegation) bool {
+		ubds = append(ubds, ubd)
+		return false
+	})
+	for _, ubd := range ubds {
+		if err := k.migrateUnbondingDelegation(ctx, bondDenom, ubd); err != nil {
+			return err
+		}
+	}
+
+	var reds []stakingtypes.Redelegation
+	k.stakingKeeper.IterateRedelegations(ctx, func(_ int64, red stakingtypes.Redelegation) bool {
+		reds = append(reds, red)
+		return false
+	})
+	for _, red := range reds {
+		k.migrateRedelegation(ctx, red)
+	}
+
+	for _, pool := range pools {
+		if err := k.handlePoolRemainder(ctx, pool, bondDenom); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mintPoolsSDKBond mints sdkbond tokens for the staking pools worth the
+// stake they hold, which the migration moves to the intermediary accounts.
+// They are issued for the DV pairs as the sdk delegations and unbonding
+// delegations are taken over, the rest backs the sdk delegations left
+// untouched.
+func (k Keeper) mintPoolsSDKBond(ctx sdk.Context, pools []string, bondDenom, sdkBondDenom string) error {
+	for _, pool := range pools {
+		balance := k.bankKeeper.GetBalance(ctx, k.accountKeeper.GetModuleAddress(pool), bondDenom)
+		if !balance.IsPositive() {
+			continue
+		}
+
+		coins := sdk.NewCoins(sdk.NewCoin(sdkBondDenom, balance.Amount))
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, pool, coins); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// migrateStakingDelegation moves an sdk delegation and its distribution
+// starting info to the intermediary account of its DV pair, and moves the
+// stake it is worth from the staking pool of its validator to the
+// intermediary account as the locked bond tokens of the DV pair.
+func (k Keeper) migrateStakingDelegation(ctx sdk.Context, bondDenom string, delegation stakingtypes.Delegation) error {
+	delAddr, valAddr := delegation.GetDelegatorAddr(), delegation.GetValidatorAddr()
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return stakingtypes.ErrNoValidatorFound
+	}
+
+	if denom, _ := k.GetValidatorBondDenom(ctx, valAddr); denom != bondDenom {
+		return sdkerrors.Wrapf(types.ErrMismatchedValidatorBondDenom, "validator %s is pinned to %s, expected %s", valAddr, denom, bondDenom)
+	}
+
+	// the weight of the bond denom is one, so the DV pair locks as many bond
+	// tokens as its sdk delegation is worth
+	amount := validator.TokensFromShares(delegation.Shares).TruncateInt()
+	if !amount.IsPositive() {
+		return nil
+	}
+	bondTokens := sdk.NewCoin(bondDenom, amount)
+	sdkBondTokens := sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), amount)
+
+	intermediaryAccount, err := k.takeOverDVPair(ctx, delAddr, valAddr)
+	if err != nil {
+		return err
+	}
+
+	if err := k.stakingKeeper.RemoveDelegation(ctx, delegation); err != nil {
+		return err
+	}
+	k.stakingKeeper.SetDelegation(ctx, stakingtypes.NewDelegation(intermediaryAccount, valAddr, delegation.Shares))
+
+	startingInfo := k.distrKeeper.GetDelegatorStartingInfo(ctx, valAddr, delAddr)
+	k.distrKeeper.DeleteDelegatorStartingInfo(ctx, valAddr, delAddr)
+	k.distrKeeper.SetDelegatorStartingInfo(ctx, valAddr, intermediaryAccount, startingInfo)
+
+	pool := stakingtypes.NotBondedPoolName
+	if validator.IsBonded() {
+		pool = stakingtypes.BondedPoolName
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, pool, intermediaryAccount, sdk.NewCoins(bondTokens)); err != nil {
+		return err
+	}
+
+	k.addDVPairTokens(ctx, delAddr, valAddr, bondTokens, sdkBondTokens)
+	k.addIssuedSDKBondTokens(ctx, amount)
+
+	return nil
+}
+
+// migrateUnbondingDelegation moves an sdk unbonding delegation to the
+// intermediary account of its DV pair, and moves the stake of its entries
+// from the not bonded pool to the intermediary account as the unbonding bond
+// tokens of the DV pair, which are unlocked once the entries complete.
+func (k Keeper) migrateUnbondingDelegation(ctx sdk.Context, bondDenom string, ubd stakingtypes.UnbondingDelegation) error {
+	delAddr := sdk.MustAccAddressFromBech32(ubd.DelegatorAddress)
+	valAddr, err := sdk.ValAddressFromBech32(ubd.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	intermediaryAccount, err := k.takeOverDVPair(ctx, delAddr, valAddr)
+	if err != nil {
+		return err
+	}
+
+	k.stakingKeeper.RemoveUnbondingDelegation(ctx, ubd)
+	migrated := ubd
+	migrated.DelegatorAddress = intermediaryAccount.String()
+	k.stakingKeeper.SetUnbondingDelegation(ctx, migrated)
+
+	sdkBondDenom := k.stakingKeeper.BondDenom(ctx)
+	total := math.ZeroInt()
+	for _, entry := range migrated.Entries {
+		k.stakingKeeper.InsertUBDQueue(ctx, migrated, entry.CompletionTime)
+		if entry.Balance.IsPositive() {
+			k.addDVPairUnbondingTokens(
+				ctx, delAddr, valAddr, entry.CompletionTime, sdk.NewCoin(bondDenom, entry.Balance), sdk.NewCoin(sdkBondDenom, entry.Balance),
+			)
+		}
+		total = total.Add(entry.Balance)
+	}
+	k.addIssuedSDKBondTokens(ctx, total)
+
+	if !total.IsPositive() {
+		return nil
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx, stakingtypes.NotBondedPoolName, intermediaryAccount, sdk.NewCoins(sdk.NewCoin(bondDenom, total)),
+	)
+}
+
+// migrateRedelegation moves an sdk redelegation to the intermediary account
+// of its DV pair, along with the sdk delegation it slashes, so that a slash of
+// the source validator unbonds the taken over destination delegation. A
+// redelegation whose destination delegation was left untouched stays with the
+// delegator.
+func (k Keeper) migrateRedelegation(ctx sdk.Context, red stakingtypes.Redelegation) {
+	delAddr := sdk.MustAccAddressFromBech32(red.DelegatorAddress)
+	valDstAddr, err := sdk.ValAddressFromBech32(red.ValidatorDstAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	intermediaryAccount := types.IntermediaryAccount(delAddr, valDstAddr)
+	if _, found := k.stakingKeeper.GetDelegation(ctx, intermediaryAccount, valDstAddr); !found {
+		return
+	}
+
+	k.stakingKeeper.RemoveRedelegation(ctx, red)
+	migrated := red
+	migrated.DelegatorAddress = intermediaryAccount.String()
+	k.stakingKeeper.SetRedelegation(ctx, migrated)
+	for _, entry := range migrated.Entries {
+		k.stakingKeeper.InsertRedelegationQueue(ctx, migrated, entry.CompletionTime)
+	}
+}
+
+// takeOverDVPair records the delegator of the intermediary account of a DV
+// pair being migrated, and sets its withdraw address. It returns the
+// intermediary account.
+func (k Keeper) takeOverDVPair(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.AccAddress, error) {
+	intermediaryAccount := types.IntermediaryAccount(delAddr, valAddr)
+	k.SetIntermediaryAccountDelegator(ctx, intermediaryAccount, delAddr)
+	if err := k.setRewardsWithdrawAddr(ctx, intermediaryAccount, delAddr); err != nil {
+		return nil, err
+	}
+	return intermediaryAccount, nil
+}
+
+// handlePoolRemainder sends the stake left in a staking pool once the sdk
+// delegations and unbonding delegations are migrated to the unbonding
+// remainder recipient, or burns it if there is none.
+func (k Keeper) handlePoolRemainder(ctx sdk.Context, pool, bondDenom string) error {
+	remainder := k.bankKeeper.GetBalance(ctx, k.accountKeeper.GetModuleAddress(pool), bondDenom)
+	if !remainder.IsPositive() {
+		return nil
+	}
+
+	coins := sdk.NewCoins(remainder)
+	if recipient := k.UnbondingRemainderRecipient(ctx); recipient != nil {
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, pool, recipient, coins)
+	}
+	return k.bankKeeper.BurnCoins(ctx, pool, coins)
+}
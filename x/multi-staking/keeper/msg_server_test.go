@@ -0,0 +1,179 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+func (suite *KeeperTestSuite) TestMsgCreateValidator() {
+	testCases := []struct {
+		name      string
+		bondDenom string
+		value     sdk.Coin
+		setup     func(valAddr sdk.ValAddress)
+		expectErr error
+	}{
+		{
+			name:      "create validator",
+			bondDenom: bondDenom,
+			value:     sdk.NewInt64Coin(bondDenom, 1000),
+		},
+		{
+			name:      "not a bond token",
+			bondDenom: "uother",
+			value:     sdk.NewInt64Coin("uother", 1000),
+			expectErr: types.ErrInvalidBondDenom,
+		},
+		{
+			name:      "self delegation not matching the bond denom",
+			bondDenom: bondDenom,
+			value:     sdk.NewInt64Coin("uother", 1000),
+			setup: func(sdk.ValAddress) {
+				suite.msKeeper.SetBondTokenWeight(suite.ctx, "uother", sdk.OneDec())
+			},
+			expectErr: types.ErrMismatchedValidatorBondDenom,
+		},
+		{
+			name:      "validator already pinned to a bond denom",
+			bondDenom: bondDenom,
+			value:     sdk.NewInt64Coin(bondDenom, 1000),
+			setup: func(valAddr sdk.ValAddress) {
+				suite.msKeeper.SetValidatorBondDenom(suite.ctx, valAddr, bondDenom)
+			},
+			expectErr: stakingtypes.ErrValidatorOwnerExists,
+		},
+		{
+			name:      "self delegation worth no sdkbond tokens",
+			bondDenom: bondDenom,
+			value:     sdk.NewInt64Coin(bondDenom, 1),
+			expectErr: sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			sdkBondDenom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+			delAddr := suite.fundedAccount(sdk.NewCoins(tc.value))
+			valAddr := sdk.ValAddress(delAddr)
+			if tc.setup != nil {
+				tc.setup(valAddr)
+			}
+
+			msg, err := types.NewMsgCreateValidator(
+				valAddr, ed25519.GenPrivKey().PubKey(), tc.value,
+				stakingtypes.NewDescription("moniker", "", "", "", ""),
+				stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+				sdk.OneInt(), tc.bondDenom,
+			)
+			suite.Require().NoError(err)
+
+			_, err = suite.msgServer.CreateValidator(sdk.WrapSDKContext(suite.ctx), msg)
+			if tc.expectErr != nil {
+				suite.Require().ErrorIs(err, tc.expectErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			validatorBondDenom, found := suite.msKeeper.GetValidatorBondDenom(suite.ctx, valAddr)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.bondDenom, validatorBondDenom)
+
+			// the self-delegation is made by the intermediary account with sdkbond tokens
+			intermediaryAccount := types.IntermediaryAccount(delAddr, valAddr)
+			expectedSDKBond := sdk.NewCoin(sdkBondDenom, bondWeight.MulInt(tc.value.Amount).TruncateInt())
+			suite.Require().Equal(tc.value, suite.app.BankKeeper.GetBalance(suite.ctx, intermediaryAccount, tc.value.Denom))
+
+			validator, found := suite.app.StakingKeeper.GetValidator(suite.ctx, valAddr)
+			suite.Require().True(found)
+			suite.Require().Equal(expectedSDKBond.Amount, validator.GetTokens())
+			delegation, found := suite.app.StakingKeeper.GetDelegation(suite.ctx, intermediaryAccount, valAddr)
+			suite.Require().True(found)
+			suite.Require().Equal(expectedSDKBond.Amount, validator.TokensFromShares(delegation.Shares).TruncateInt())
+
+			sdkBondTokens, _ := suite.msKeeper.GetDVPairSDKBondTokens(suite.ctx, delAddr, valAddr)
+			suite.Require().Equal(expectedSDKBond, sdkBondTokens)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgDelegate() {
+	testCases := []struct {
+		name      string
+		amount    sdk.Coin
+		setup     func()
+		expectErr error
+	}{
+		{
+			name:   "delegate bond token",
+			amount: sdk.NewInt64Coin(bondDenom, 1000),
+		},
+		{
+			name:      "not a bond token",
+			amount:    sdk.NewInt64Coin("uother", 1000),
+			expectErr: types.ErrInvalidBondDenom,
+		},
+		{
+			name:   "bond token not matching the validator bond denom",
+			amount: sdk.NewInt64Coin("uother", 1000),
+			setup: func() {
+				suite.msKeeper.SetBondTokenWeight(suite.ctx, "uother", sdk.OneDec())
+			},
+			expectErr: types.ErrMismatchedValidatorBondDenom,
+		},
+		{
+			name:   "validator without bond denom",
+			amount: sdk.NewInt64Coin(bondDenom, 1000),
+			setup: func() {
+				suite.msKeeper.DeleteValidatorBondDenom(suite.ctx, suite.validator.GetOperator())
+			},
+			expectErr: types.ErrValidatorBondDenomNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			valAddr := suite.validator.GetOperator()
+			sdkBondDenom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+			if tc.setup != nil {
+				tc.setup()
+			}
+			delAddr := suite.fundedAccount(sdk.NewCoins(tc.amount))
+
+			_, err := suite.msgServer.Delegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgDelegate(delAddr, valAddr, tc.amount))
+			if tc.expectErr != nil {
+				suite.Require().ErrorIs(err, tc.expectErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			intermediaryAccount := types.IntermediaryAccount(delAddr, valAddr)
+			expectedSDKBond := sdk.NewCoin(sdkBondDenom, bondWeight.MulInt(tc.amount.Amount).TruncateInt())
+
+			// bond tokens are locked in the intermediary account
+			suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, delAddr, tc.amount.Denom).IsZero())
+			suite.Require().Equal(tc.amount, suite.app.BankKeeper.GetBalance(suite.ctx, intermediaryAccount, tc.amount.Denom))
+
+			// the minted sdkbond tokens are delegated by the intermediary account
+			suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, intermediaryAccount, sdkBondDenom).IsZero())
+			delegation, found := suite.app.StakingKeeper.GetDelegation(suite.ctx, intermediaryAccount, valAddr)
+			suite.Require().True(found)
+			validator, _ := suite.app.StakingKeeper.GetValidator(suite.ctx, valAddr)
+			suite.Require().Equal(expectedSDKBond.Amount, validator.TokensFromShares(delegation.Shares).TruncateInt())
+
+			delegator, found := suite.msKeeper.GetIntermediaryAccountDelegator(suite.ctx, intermediaryAccount)
+			suite.Require().True(found)
+			suite.Require().Equal(delAddr, delegator)
+
+			bondTokens, _ := suite.msKeeper.GetDVPairBondTokens(suite.ctx, delAddr, valAddr)
+			suite.Require().Equal(tc.amount, bondTokens)
+			sdkBondTokens, _ := suite.msKeeper.GetDVPairSDKBondTokens(suite.ctx, delAddr, valAddr)
+			suite.Require().Equal(expectedSDKBond, sdkBondTokens)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// UnbondingRemainderRecipient returns the address receiving the unbonding
+// remainders, or nil if they are burned
+func (k Keeper) UnbondingRemainderRecipient(ctx sdk.Context) sdk.AccAddress {
+	var recipient string
+	k.paramstore.Get(ctx, types.KeyUnbondingRemainderRecipient, &recipient)
+	if recipient == "" {
+		return nil
+	}
+	return sdk.MustAccAddressFromBech32(recipient)
+}
+
+// ReweightingBatchSize returns the maximum number of DV pairs scanned by the
+// reweighting jobs in a block
+func (k Keeper) ReweightingBatchSize(ctx sdk.Context) (res uint32) {
+	k.paramstore.Get(ctx, types.KeyReweightingBatchSize, &res)
+	return
+}
+
+// WeightEpochLength returns the number of blocks between two updates of the
+// dynamic bond token weights
+func (k Keeper) WeightEpochLength(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyWeightEpochLength, &res)
+	return
+}
+
+// SunsetBatchSize returns the maximum number of DV pairs scanned in a block
+// to force-undelegate the sunsetting bond denoms
+func (k Keeper) SunsetBatchSize(ctx sdk.Context) (res uint32) {
+	k.paramstore.Get(ctx, types.KeySunsetBatchSize, &res)
+	return
+}
+
+// SDKBondTransferable returns true if users may transfer the sdkbond tokens
+// they hold
+func (k Keeper) SDKBondTransferable(ctx sdk.Context) (res bool) {
+	k.paramstore.Get(ctx, types.KeySDKBondTransferable, &res)
+	return
+}
+
+// GetParams returns the total set of multi-staking parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramstore.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the multi-staking parameters to the param space.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramstore.SetParamSet(ctx, &params)
+}
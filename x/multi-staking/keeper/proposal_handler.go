@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// HandleAddBondDenomProposal is a handler for executing a passed add bond denom proposal
+func HandleAddBondDenomProposal(ctx sdk.Context, k Keeper, p *types.AddBondDenomProposal) error {
+	if k.IsBondDenom(ctx, p.BondDenom) {
+		return sdkerrors.Wrapf(types.ErrBondDenomAlreadyExists, "%s", p.BondDenom)
+	}
+	// the sdkbond tokens are minted for the bond tokens, they cannot back themselves
+	if p.BondDenom == k.stakingKeeper.BondDenom(ctx) {
+		return sdkerrors.Wrapf(types.ErrInvalidBondDenom, "%s is the sdkbond denom", p.BondDenom)
+	}
+	if err := k.ValidateIBCBondDenom(ctx, p.BondDenom, p.SourceChannel); err != nil {
+		return err
+	}
+
+	k.SetBondTokenWeight(ctx, p.BondDenom, p.BondTokenWeight)
+	return nil
+}
+
+// HandleChangeBondTokenWeightProposal is a handler for executing a passed change bond token weight proposal
+func HandleChangeBondTokenWeightProposal(ctx sdk.Context, k Keeper, p *types.ChangeBondTokenWeightProposal) error {
+	if !k.IsBondDenom(ctx, p.BondDenom) {
+		return sdkerrors.Wrapf(types.ErrInvalidBondDenom, "%s", p.BondDenom)
+	}
+	if k.IsBondDenomSunsetting(ctx, p.BondDenom) {
+		return sdkerrors.Wrapf(types.ErrBondDenomSunsetting, "%s", p.BondDenom)
+	}
+
+	k.changeBondTokenWeight(ctx, p.BondDenom, p.BondTokenWeight)
+	return nil
+}
+
+// HandleRemoveBondTokenProposal is a handler for executing a passed remove bond token proposal
+func HandleRemoveBondTokenProposal(ctx sdk.Context, k Keeper, p *types.RemoveBondTokenProposal) error {
+	return k.SunsetBondDenom(ctx, p.BondDenom)
+}
+
+// HandleSetStakingCapsProposal is a handler for executing a passed set staking caps proposal
+func HandleSetStakingCapsProposal(ctx sdk.Context, k Keeper, p *types.SetStakingCapsProposal) error {
+	if !k.IsBondDenom(ctx, p.BondDenom) {
+		return sdkerrors.Wrapf(types.ErrInvalidBondDenom, "%s", p.BondDenom)
+	}
+
+	if !p.Caps.IsCapped() {
+		k.DeleteStakingCaps(ctx, p.BondDenom)
+		return nil
+	}
+	k.SetStakingCaps(ctx, p.BondDenom, p.Caps)
+	return nil
+}
+
+// HandleConversionReserveSpendProposal is a handler for executing a passed conversion reserve spend proposal
+func HandleConversionReserveSpendProposal(ctx sdk.Context, k Keeper, p *types.ConversionReserveSpendProposal) error {
+	recipient, err := sdk.AccAddressFromBech32(p.Recipient)
+	if err != nil {
+		return err
+	}
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", p.Recipient)
+	}
+
+	if err := k.payFromConversionReserve(ctx, recipient, p.Amount); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("transferred from the conversion reserve to recipient", "amount", p.Amount.String(), "recipient", p.Recipient)
+	return nil
+}
+
+// HandleSetWeightBoundsProposal is a handler for executing a passed set weight bounds proposal
+func HandleSetWeightBoundsProposal(ctx sdk.Context, k Keeper, p *types.SetWeightBoundsProposal) error {
+	if !k.IsBondDenom(ctx, p.BondDenom) {
+		return sdkerrors.Wrapf(types.ErrInvalidBondDenom, "%s", p.BondDenom)
+	}
+	if k.IsBondDenomSunsetting(ctx, p.BondDenom) {
+		return sdkerrors.Wrapf(types.ErrBondDenomSunsetting, "%s", p.BondDenom)
+	}
+
+	if p.Bounds.IsZero() {
+		k.DeleteWeightBounds(ctx, p.BondDenom)
+		return nil
+	}
+	k.SetWeightBounds(ctx, p.BondDenom, p.Bounds)
+	return nil
+}
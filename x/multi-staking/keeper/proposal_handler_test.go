@@ -0,0 +1,249 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+
+	multistaking "github.com/notional-labs/multi-staking-module/x/multi-staking"
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+func (suite *KeeperTestSuite) TestProposalValidateBasic() {
+	ibcDenom := ibctransfertypes.ParseDenomTrace("transfer/channel-0/uatom").IBCDenom()
+
+	testCases := []struct {
+		name      string
+		proposal  govv1beta1.Content
+		expectErr error
+	}{
+		{
+			name:     "valid add",
+			proposal: types.NewAddBondDenomProposal("title", "description", "uatom", sdk.OneDec(), ""),
+		},
+		{
+			name:      "zero weight",
+			proposal:  types.NewAddBondDenomProposal("title", "description", "uatom", sdk.ZeroDec(), ""),
+			expectErr: types.ErrInvalidBondTokenWeight,
+		},
+		{
+			name:     "valid add with source channel",
+			proposal: types.NewAddBondDenomProposal("title", "description", ibcDenom, sdk.OneDec(), "channel-0"),
+		},
+		{
+			name:      "source channel of native denom",
+			proposal:  types.NewAddBondDenomProposal("title", "description", "uatom", sdk.OneDec(), "channel-0"),
+			expectErr: types.ErrInvalidSourceChannel,
+		},
+		{
+			name:      "invalid source channel",
+			proposal:  types.NewAddBondDenomProposal("title", "description", ibcDenom, sdk.OneDec(), "channel"),
+			expectErr: types.ErrInvalidSourceChannel,
+		},
+		{
+			name:      "invalid ibc denom hash",
+			proposal:  types.NewAddBondDenomProposal("title", "description", "ibc/atom", sdk.OneDec(), ""),
+			expectErr: types.ErrInvalidBondDenom,
+		},
+		{
+			name:      "negative weight",
+			proposal:  types.NewChangeBondTokenWeightProposal("title", "description", bondDenom, sdk.NewDec(-1)),
+			expectErr: types.ErrInvalidBondTokenWeight,
+		},
+		{
+			name:      "nil weight",
+			proposal:  types.NewChangeBondTokenWeightProposal("title", "description", bondDenom, sdk.Dec{}),
+			expectErr: types.ErrInvalidBondTokenWeight,
+		},
+		{
+			name:      "invalid denom",
+			proposal:  types.NewRemoveBondTokenProposal("title", "description", "1"),
+			expectErr: types.ErrInvalidBondDenom,
+		},
+		{
+			name:     "valid set staking caps",
+			proposal: types.NewSetStakingCapsProposal("title", "description", bondDenom, types.NewStakingCaps(sdk.NewInt(1000), sdk.MustNewDecFromStr("0.3"))),
+		},
+		{
+			name:     "remove staking caps",
+			proposal: types.NewSetStakingCapsProposal("title", "description", bondDenom, types.NewStakingCaps(sdk.ZeroInt(), sdk.ZeroDec())),
+		},
+		{
+			name:      "negative max bonded tokens",
+			proposal:  types.NewSetStakingCapsProposal("title", "description", bondDenom, types.NewStakingCaps(sdk.NewInt(-1), sdk.ZeroDec())),
+			expectErr: types.ErrInvalidStakingCaps,
+		},
+		{
+			name:      "max power share of one",
+			proposal:  types.NewSetStakingCapsProposal("title", "description", bondDenom, types.NewStakingCaps(sdk.ZeroInt(), sdk.OneDec())),
+			expectErr: types.ErrInvalidStakingCaps,
+		},
+		{
+			name:     "valid set weight bounds",
+			proposal: types.NewSetWeightBoundsProposal("title", "description", bondDenom, types.NewWeightBounds(sdk.MustNewDecFromStr("0.1"), sdk.OneDec(), sdk.MustNewDecFromStr("0.05"))),
+		},
+		{
+			name:     "static weight",
+			proposal: types.NewSetWeightBoundsProposal("title", "description", bondDenom, types.NewWeightBounds(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())),
+		},
+		{
+			name:      "zero min weight",
+			proposal:  types.NewSetWeightBoundsProposal("title", "description", bondDenom, types.NewWeightBounds(sdk.ZeroDec(), sdk.OneDec(), sdk.ZeroDec())),
+			expectErr: types.ErrInvalidWeightBounds,
+		},
+		{
+			name:      "max weight below the min weight",
+			proposal:  types.NewSetWeightBoundsProposal("title", "description", bondDenom, types.NewWeightBounds(sdk.OneDec(), sdk.MustNewDecFromStr("0.5"), sdk.ZeroDec())),
+			expectErr: types.ErrInvalidWeightBounds,
+		},
+		{
+			name:      "negative max change rate",
+			proposal:  types.NewSetWeightBoundsProposal("title", "description", bondDenom, types.NewWeightBounds(sdk.MustNewDecFromStr("0.1"), sdk.OneDec(), sdk.NewDec(-1))),
+			expectErr: types.ErrInvalidWeightBounds,
+		},
+		{
+			name:     "valid conversion reserve spend",
+			proposal: types.NewConversionReserveSpendProposal("title", "description", sdk.AccAddress([]byte("recipient")), sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))),
+		},
+		{
+			name:      "empty conversion reserve spend",
+			proposal:  types.NewConversionReserveSpendProposal("title", "description", sdk.AccAddress([]byte("recipient")), sdk.NewCoins()),
+			expectErr: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name:      "empty title",
+			proposal:  types.NewRemoveBondTokenProposal("", "description", bondDenom),
+			expectErr: govtypes.ErrInvalidProposalContent,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.proposal.ValidateBasic()
+			if tc.expectErr != nil {
+				suite.Require().ErrorIs(err, tc.expectErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// the proposal is amino JSON signed with its type within the gov messages
+			proposer := suite.fundedAccount(sdk.NewCoins())
+			msg, err := govv1beta1.NewMsgSubmitProposal(tc.proposal, sdk.NewCoins(), proposer)
+			suite.Require().NoError(err)
+			suite.Require().Contains(string(msg.GetSignBytes()), `"type":"multistaking/`)
+			legacyContent, err := govv1.NewLegacyContent(tc.proposal, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+			suite.Require().NoError(err)
+			msgV1, err := govv1.NewMsgSubmitProposal([]sdk.Msg{legacyContent}, sdk.NewCoins(), proposer.String(), "")
+			suite.Require().NoError(err)
+			suite.Require().Contains(string(msgV1.GetSignBytes()), `"type":"multistaking/`)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestBondDenomProposals() {
+	newWeight := sdk.MustNewDecFromStr("0.3")
+
+	testCases := []struct {
+		name         string
+		proposal     govv1beta1.Content
+		delegate     bool
+		sunset       bool
+		expectErr    error
+		expectWeight *sdk.Dec
+	}{
+		{
+			name:         "add bond denom",
+			proposal:     types.NewAddBondDenomProposal("title", "description", "uatom", newWeight, ""),
+			expectWeight: &newWeight,
+		},
+		{
+			name:      "add existing bond denom",
+			proposal:  types.NewAddBondDenomProposal("title", "description", bondDenom, newWeight, ""),
+			expectErr: types.ErrBondDenomAlreadyExists,
+		},
+		{
+			name:      "add sdkbond denom",
+			proposal:  types.NewAddBondDenomProposal("title", "description", sdk.DefaultBondDenom, newWeight, ""),
+			expectErr: types.ErrInvalidBondDenom,
+		},
+		{
+			name:         "change bond token weight",
+			proposal:     types.NewChangeBondTokenWeightProposal("title", "description", bondDenom, newWeight),
+			delegate:     true,
+			expectWeight: &newWeight,
+		},
+		{
+			name:      "change weight of unknown denom",
+			proposal:  types.NewChangeBondTokenWeightProposal("title", "description", "uatom", newWeight),
+			expectErr: types.ErrInvalidBondDenom,
+		},
+		{
+			name:         "remove bond token",
+			proposal:     types.NewRemoveBondTokenProposal("title", "description", bondDenom),
+			delegate:     true,
+			expectWeight: &bondWeight,
+		},
+		{
+			name:      "remove sunsetting bond token",
+			proposal:  types.NewRemoveBondTokenProposal("title", "description", bondDenom),
+			sunset:    true,
+			expectErr: types.ErrBondDenomSunsetting,
+		},
+		{
+			name:      "change weight of sunsetting bond token",
+			proposal:  types.NewChangeBondTokenWeightProposal("title", "description", bondDenom, newWeight),
+			sunset:    true,
+			expectErr: types.ErrBondDenomSunsetting,
+		},
+		{
+			name:      "remove unknown denom",
+			proposal:  types.NewRemoveBondTokenProposal("title", "description", "uatom"),
+			expectErr: types.ErrInvalidBondDenom,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			valAddr := suite.validator.GetOperator()
+			if tc.delegate {
+				delegated := sdk.NewInt64Coin(bondDenom, 1000)
+				delAddr := suite.fundedAccount(sdk.NewCoins(delegated))
+				_, err := suite.msgServer.Delegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgDelegate(delAddr, valAddr, delegated))
+				suite.Require().NoError(err)
+			}
+			if tc.sunset {
+				suite.Require().NoError(suite.msKeeper.SunsetBondDenom(suite.ctx, bondDenom))
+			}
+
+			handler := multistaking.NewProposalHandler(suite.msKeeper)
+			err := handler(suite.ctx, tc.proposal)
+			if tc.expectErr != nil {
+				suite.Require().ErrorIs(err, tc.expectErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			var denom string
+			switch p := tc.proposal.(type) {
+			case *types.AddBondDenomProposal:
+				denom = p.BondDenom
+			case *types.ChangeBondTokenWeightProposal:
+				denom = p.BondDenom
+			case *types.RemoveBondTokenProposal:
+				denom = p.BondDenom
+			}
+			weight, found := suite.msKeeper.GetBondTokenWeight(suite.ctx, denom)
+			if tc.expectWeight == nil {
+				suite.Require().False(found)
+			} else {
+				suite.Require().True(found)
+				suite.Require().Equal(*tc.expectWeight, weight)
+			}
+		})
+	}
+}
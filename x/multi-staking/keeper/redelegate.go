@@ -0,0 +1,309 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// BeginRedelegation redelegates an amount of bond tokens of a delegator from a
+// source validator to a destination validator. The sdk delegation shares
+// backing the bond tokens move from the intermediary account of the source DV
+// pair to the one of the destination DV pair, along with the locked bond
+// tokens, and the sdk redelegation is recorded for the destination
+// intermediary account, so that the staking module slashes it for the
+// infractions of the source validator.
+//
+// Validators pinned to different bond denoms only accept redelegations with
+// convert set, in which case the bond tokens are exchanged with the conversion
+// reserve at the ratio of the bond token weights. The sdkbond tokens of the
+// destination DV pair are then minted or unbonded to match its new bond
+// tokens. It returns the completion time and the bond tokens delegated to the
+// destination validator.
+func (k Keeper) BeginRedelegation(
+	ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, amount sdk.Coin, convert bool,
+) (time.Time, sdk.Coin, error) {
+	if bytes.Equal(valSrcAddr, valDstAddr) {
+		return time.Time{}, sdk.Coin{}, stakingtypes.ErrSelfRedelegation
+	}
+	if err := k.validateValidatorBondDenom(ctx, valSrcAddr, amount.Denom); err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+
+	srcValidator, found := k.stakingKeeper.GetValidator(ctx, valSrcAddr)
+	if !found {
+		return time.Time{}, sdk.Coin{}, stakingtypes.ErrBadRedelegationDst
+	}
+	dstValidator, found := k.stakingKeeper.GetValidator(ctx, valDstAddr)
+	if !found {
+		return time.Time{}, sdk.Coin{}, stakingtypes.ErrBadRedelegationDst
+	}
+
+	dstBondTokens, err := k.redelegatedBondTokens(ctx, valDstAddr, amount, convert)
+	if err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+
+	srcIntermediaryAccount := types.IntermediaryAccount(delAddr, valSrcAddr)
+	dstIntermediaryAccount := types.IntermediaryAccount(delAddr, valDstAddr)
+
+	// the checks of the staking module for the redelegation of the source
+	// intermediary account, with the entries recorded for the destination one
+	if k.stakingKeeper.HasReceivingRedelegation(ctx, srcIntermediaryAccount, valSrcAddr) {
+		return time.Time{}, sdk.Coin{}, stakingtypes.ErrTransitiveRedelegation
+	}
+	if k.stakingKeeper.HasMaxRedelegationEntries(ctx, dstIntermediaryAccount, valSrcAddr, valDstAddr) {
+		return time.Time{}, sdk.Coin{}, stakingtypes.ErrMaxRedelegationEntries
+	}
+
+	shares, sdkBondAmount, err := k.unbondShares(ctx, delAddr, valSrcAddr, amount)
+	if err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+
+	if _, err := k.withdrawRewards(ctx, delAddr, valSrcAddr); err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+	if _, found := k.stakingKeeper.GetDelegation(ctx, dstIntermediaryAccount, valDstAddr); found {
+		if _, err := k.withdrawRewards(ctx, delAddr, valDstAddr); err != nil {
+			return time.Time{}, sdk.Coin{}, err
+		}
+	}
+
+	if err := k.moveBondTokens(ctx, delAddr, srcIntermediaryAccount, dstIntermediaryAccount, amount, dstBondTokens); err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+
+	returnAmount, err := k.stakingKeeper.Unbond(ctx, srcIntermediaryAccount, valSrcAddr, shares)
+	if err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+	if returnAmount.IsZero() {
+		return time.Time{}, sdk.Coin{}, stakingtypes.ErrTinyRedelegationAmount
+	}
+
+	// the redelegated sdkbond tokens stay in the pool of the source validator
+	// status until the staking module moves them to the destination one
+	sharesCreated, err := k.stakingKeeper.Delegate(ctx, dstIntermediaryAccount, returnAmount, srcValidator.GetStatus(), dstValidator, false)
+	if err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+
+	completionTime, height, completeNow := k.redelegationBeginInfo(ctx, valSrcAddr)
+	if !completeNow {
+		red := k.stakingKeeper.SetRedelegationEntry(
+			ctx, dstIntermediaryAccount, valSrcAddr, valDstAddr,
+			height, completionTime, returnAmount, shares, sharesCreated,
+		)
+		k.stakingKeeper.InsertRedelegationQueue(ctx, red, completionTime)
+	}
+
+	sdkBondTokens := sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), sdkBondAmount)
+	k.subDVPairTokens(ctx, delAddr, valSrcAddr, amount, sdkBondTokens)
+	k.addDVPairTokens(ctx, delAddr, valDstAddr, dstBondTokens, sdkBondTokens)
+
+	// the sdkbond tokens of the destination DV pair may differ from its bond
+	// tokens times the weight by the rounding, or by the conversion
+	weight, _ := k.GetBondTokenWeight(ctx, dstBondTokens.Denom)
+	bondTokens, _ := k.GetDVPairBondTokens(ctx, delAddr, valDstAddr)
+	if _, _, err := k.syncDVPairSDKBondTokens(ctx, delAddr, valDstAddr, bondTokens, weight); err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+
+	if err := k.jailBelowMinSelfDelegation(ctx, delAddr, valSrcAddr); err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+
+	return completionTime, dstBondTokens, nil
+}
+
+// redelegatedBondTokens returns the bond tokens of the destination validator
+// that an amount of bond tokens is redelegated as. Bond tokens are converted
+// at the ratio of the weights of their denoms, within the staking caps of the
+// destination bond denom.
+func (k Keeper) redelegatedBondTokens(ctx sdk.Context, valDstAddr sdk.ValAddress, amount sdk.Coin, convert bool) (sdk.Coin, error) {
+	dstDenom, found := k.GetValidatorBondDenom(ctx, valDstAddr)
+	if !found {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrValidatorBondDenomNotFound, "validator %s", valDstAddr)
+	}
+	if k.IsBondDenomSunsetting(ctx, dstDenom) {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrBondDenomSunsetting, "%s", dstDenom)
+	}
+
+	if dstDenom == amount.Denom {
+		return amount, nil
+	}
+	if !convert {
+		return sdk.Coin{}, sdkerrors.Wrapf(
+			types.ErrRedelegationBondDenomMismatch, "cannot redelegate %s to validator %s of %s", amount, valDstAddr, dstDenom,
+		)
+	}
+
+	srcWeight, _ := k.GetBondTokenWeight(ctx, amount.Denom)
+	dstWeight, found := k.GetBondTokenWeight(ctx, dstDenom)
+	if !found {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidBondDenom, "%s", dstDenom)
+	}
+
+	converted := srcWeight.MulInt(amount.Amount).Quo(dstWeight).TruncateInt()
+	if !converted.IsPositive() {
+		return sdk.Coin{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "redelegation amount %s is too small to convert", amount)
+	}
+
+	// the converted bond tokens count against the staking caps of their denom
+	// as a delegation of them
+	dstBondTokens := sdk.NewCoin(dstDenom, converted)
+	if err := k.validateStakingCaps(ctx, dstBondTokens, dstWeight.MulInt(converted).TruncateInt()); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return dstBondTokens, nil
+}
+
+// moveBondTokens moves the locked bond tokens of a redelegation from the source
+// intermediary account to the destination one, exchanging them with the
+// conversion reserve if they are converted. Vesting accounts cannot convert
+// bond tokens of a denom they have delegated vesting tokens of.
+func (k Keeper) moveBondTokens(
+	ctx sdk.Context, delAddr, srcIntermediaryAccount, dstIntermediaryAccount sdk.AccAddress, amount, dstBondTokens sdk.Coin,
+) error {
+	if _, found := k.GetIntermediaryAccountDelegator(ctx, dstIntermediaryAccount); !found {
+		k.SetIntermediaryAccountDelegator(ctx, dstIntermediaryAccount, delAddr)
+	}
+	if err := k.setRewardsWithdrawAddr(ctx, dstIntermediaryAccount, delAddr); err != nil {
+		return err
+	}
+
+	if amount.Denom == dstBondTokens.Denom {
+		return k.bankKeeper.SendCoins(ctx, srcIntermediaryAccount, dstIntermediaryAccount, sdk.NewCoins(amount))
+	}
+
+	// the bank keeper tracks the delegated vesting tokens of a vesting account
+	// in their denom, so converting them would unlock them in another denom
+	if vacc, ok := k.accountKeeper.GetAccount(ctx, delAddr).(vestexported.VestingAccount); ok &&
+		vacc.GetDelegatedVesting().AmountOf(amount.Denom).IsPositive() {
+		return sdkerrors.Wrapf(types.ErrConvertDelegatedVesting, "%s has delegated vesting %s", delAddr, amount.Denom)
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, srcIntermediaryAccount, types.ConversionReserve(), sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+	return k.payFromConversionReserve(ctx, dstIntermediaryAccount, sdk.NewCoins(dstBondTokens))
+}
+
+// redelegationBeginInfo returns the completion time and height of a
+// redelegation from a validator, and whether it completes right away, as the
+// staking module does.
+func (k Keeper) redelegationBeginInfo(ctx sdk.Context, valSrcAddr sdk.ValAddress) (time.Time, int64, bool) {
+	validator, found := k.stakingKeeper.GetValidator(ctx, valSrcAddr)
+
+	switch {
+	case !found || validator.IsBonded():
+		return ctx.BlockHeader().Time.Add(k.stakingKeeper.UnbondingTime(ctx)), ctx.BlockHeight(), false
+	case validator.IsUnbonded():
+		return time.Time{}, 0, true
+	case validator.IsUnbonding():
+		return validator.UnbondingTime, validator.UnbondingHeight, false
+	default:
+		panic(fmt.Sprintf("unknown validator status: %s", validator.Status))
+	}
+}
+
+// CollectCompletedRedelegations records the sdk redelegations of intermediary
+// accounts which the staking module completes in this block, along with the
+// bond denoms of their validators, so that they can be reported in EndBlock.
+func (k Keeper) CollectCompletedRedelegations(ctx sdk.Context) {
+	blockTime := ctx.BlockTime()
+	sdkBondDenom := k.stakingKeeper.BondDenom(ctx)
+
+	var completed []types.CompletedRedelegation
+	seen := make(map[string]bool)
+
+	iterator := k.stakingKeeper.RedelegationQueueIterator(ctx, blockTime)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var timeslice stakingtypes.DVVTriplets
+		k.cdc.MustUnmarshal(iterator.Value(), &timeslice)
+
+		for _, triplet := range timeslice.Triplets {
+			if seen[triplet.String()] {
+				continue
+			}
+			seen[triplet.String()] = true
+
+			intermediaryAccount := sdk.MustAccAddressFromBech32(triplet.DelegatorAddress)
+			delAddr, found := k.GetIntermediaryAccountDelegator(ctx, intermediaryAccount)
+			if !found {
+				continue
+			}
+
+			valSrcAddr, err := sdk.ValAddressFromBech32(triplet.ValidatorSrcAddress)
+			if err != nil {
+				panic(err)
+			}
+			valDstAddr, err := sdk.ValAddressFromBech32(triplet.ValidatorDstAddress)
+			if err != nil {
+				panic(err)
+			}
+
+			red, found := k.stakingKeeper.GetRedelegation(ctx, intermediaryAccount, valSrcAddr, valDstAddr)
+			if !found {
+				continue
+			}
+
+			amount := math.ZeroInt()
+			for _, entry := range red.Entries {
+				if entry.IsMature(blockTime) {
+					amount = amount.Add(entry.InitialBalance)
+				}
+			}
+			if amount.IsZero() {
+				continue
+			}
+
+			srcDenom, _ := k.GetValidatorBondDenom(ctx, valSrcAddr)
+			dstDenom, _ := k.GetValidatorBondDenom(ctx, valDstAddr)
+			completed = append(completed, types.CompletedRedelegation{
+				DelegatorAddress:    delAddr.String(),
+				ValidatorSrcAddress: triplet.ValidatorSrcAddress,
+				ValidatorDstAddress: triplet.ValidatorDstAddress,
+				Amount:              sdk.NewCoin(sdkBondDenom, amount),
+				SrcBondDenom:        srcDenom,
+				DstBondDenom:        dstDenom,
+			})
+		}
+	}
+
+	if len(completed) > 0 {
+		k.SetCompletedRedelegations(ctx, completed)
+	}
+}
+
+// CompleteRedelegations reports the redelegations completed by the staking
+// module in this block.
+func (k Keeper) CompleteRedelegations(ctx sdk.Context) {
+	for _, completed := range k.GetCompletedRedelegations(ctx) {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCompleteRedelegation,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, completed.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyDelegator, completed.DelegatorAddress),
+				sdk.NewAttribute(types.AttributeKeySrcValidator, completed.ValidatorSrcAddress),
+				sdk.NewAttribute(types.AttributeKeyDstValidator, completed.ValidatorDstAddress),
+				sdk.NewAttribute(types.AttributeKeySrcBondDenom, completed.SrcBondDenom),
+				sdk.NewAttribute(types.AttributeKeyDstBondDenom, completed.DstBondDenom),
+			),
+		)
+	}
+
+	k.DeleteCompletedRedelegations(ctx)
+}
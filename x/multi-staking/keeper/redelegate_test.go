@@ -0,0 +1,230 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	multistaking "github.com/notional-labs/multi-staking-module/x/multi-staking"
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+const convertDenom = "uatom"
+
+// eventAttributes returns the attributes of the last event of the given type,
+// which follows the staking module event of the same type
+func eventAttributes(events []abci.Event, eventType string) map[string]string {
+	var attributes map[string]string
+	for _, event := range events {
+		if event.Type != eventType {
+			continue
+		}
+		attributes = make(map[string]string)
+		for _, attr := range event.Attributes {
+			attributes[string(attr.Key)] = string(attr.Value)
+		}
+	}
+	return attributes
+}
+
+func (suite *KeeperTestSuite) TestBeginRedelegate() {
+	suite.disableInflation()
+	valSrcAddr := suite.validator.GetOperator()
+	valDstAddr := suite.createValidator(sdk.NewInt64Coin(bondDenom, 1000))
+	suite.nextBlock(suite.ctx.BlockTime())
+
+	delegated := sdk.NewInt64Coin(bondDenom, 1001)
+	delAddr := suite.delegateAll(valSrcAddr, delegated.Amount.Int64())[0]
+	srcIntermediaryAccount := types.IntermediaryAccount(delAddr, valSrcAddr)
+	dstIntermediaryAccount := types.IntermediaryAccount(delAddr, valDstAddr)
+
+	redelegated := sdk.NewInt64Coin(bondDenom, 501)
+	res, err := suite.msgServer.BeginRedelegate(
+		sdk.WrapSDKContext(suite.ctx), types.NewMsgBeginRedelegate(delAddr, valSrcAddr, valDstAddr, redelegated, false),
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(redelegated, res.Amount)
+	suite.requireInvariant()
+
+	// the bond tokens and the sdkbond tokens backing them move to the
+	// destination DV pair, which is synced to its bond tokens times the weight
+	sdkBondDenom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	for _, tc := range []struct {
+		valAddr       sdk.ValAddress
+		bondTokens    int64
+		sdkBondTokens int64
+	}{
+		{valSrcAddr, 500, 250},
+		{valDstAddr, 501, 250},
+	} {
+		bondTokens, _ := suite.msKeeper.GetDVPairBondTokens(suite.ctx, delAddr, tc.valAddr)
+		suite.Require().Equal(sdk.NewInt64Coin(bondDenom, tc.bondTokens), bondTokens)
+		sdkBondTokens, _ := suite.msKeeper.GetDVPairSDKBondTokens(suite.ctx, delAddr, tc.valAddr)
+		suite.Require().Equal(sdk.NewInt64Coin(sdkBondDenom, tc.sdkBondTokens), sdkBondTokens)
+		intermediaryAccount := types.IntermediaryAccount(delAddr, tc.valAddr)
+		suite.Require().Equal(sdk.NewInt64Coin(bondDenom, tc.bondTokens), suite.app.BankKeeper.GetBalance(suite.ctx, intermediaryAccount, bondDenom))
+	}
+
+	// the sdk redelegation is recorded for the destination intermediary account
+	red, found := suite.app.StakingKeeper.GetRedelegation(suite.ctx, dstIntermediaryAccount, valSrcAddr, valDstAddr)
+	suite.Require().True(found)
+	suite.Require().Len(red.Entries, 1)
+	suite.Require().Equal(res.CompletionTime, red.Entries[0].CompletionTime)
+	delegator, found := suite.msKeeper.GetIntermediaryAccountDelegator(suite.ctx, dstIntermediaryAccount)
+	suite.Require().True(found)
+	suite.Require().Equal(delAddr, delegator)
+
+	// redelegating the received delegation again is transitive
+	_, err = suite.msgServer.BeginRedelegate(
+		sdk.WrapSDKContext(suite.ctx), types.NewMsgBeginRedelegate(delAddr, valDstAddr, valSrcAddr, redelegated, false),
+	)
+	suite.Require().Error(err)
+
+	events := suite.nextBlock(res.CompletionTime)
+	attributes := eventAttributes(events, types.EventTypeCompleteRedelegation)
+	suite.Require().NotNil(attributes)
+	suite.Require().Equal(delAddr.String(), attributes[types.AttributeKeyDelegator])
+	suite.Require().Equal(valSrcAddr.String(), attributes[types.AttributeKeySrcValidator])
+	suite.Require().Equal(valDstAddr.String(), attributes[types.AttributeKeyDstValidator])
+	suite.Require().Equal(bondDenom, attributes[types.AttributeKeySrcBondDenom])
+	suite.Require().Equal(bondDenom, attributes[types.AttributeKeyDstBondDenom])
+	suite.Require().Empty(suite.msKeeper.GetCompletedRedelegations(suite.ctx))
+
+	_, found = suite.app.StakingKeeper.GetRedelegation(suite.ctx, dstIntermediaryAccount, valSrcAddr, valDstAddr)
+	suite.Require().False(found)
+	suite.Require().Equal(sdk.NewInt64Coin(bondDenom, 500), suite.app.BankKeeper.GetBalance(suite.ctx, srcIntermediaryAccount, bondDenom))
+	suite.requireInvariant()
+}
+
+func (suite *KeeperTestSuite) TestBeginRedelegateConvert() {
+	testCases := []struct {
+		name    string
+		convert bool
+		reserve int64
+		expErr  error
+	}{
+		{
+			name:    "different bond denoms without convert",
+			reserve: 1000,
+			expErr:  types.ErrRedelegationBondDenomMismatch,
+		},
+		{
+			name:    "empty conversion reserve",
+			convert: true,
+			expErr:  types.ErrInsufficientConversionReserve,
+		},
+		{
+			name:    "conversion reserve cannot pay the converted bond tokens",
+			convert: true,
+			reserve: 199,
+			expErr:  types.ErrInsufficientConversionReserve,
+		},
+		{
+			name:    "bond tokens are converted at the ratio of the weights",
+			convert: true,
+			reserve: 1000,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.disableInflation()
+			suite.msKeeper.SetBondTokenWeight(suite.ctx, convertDenom, sdk.OneDec())
+			valSrcAddr := suite.validator.GetOperator()
+			valDstAddr := suite.createValidator(sdk.NewInt64Coin(convertDenom, 1000))
+			suite.nextBlock(suite.ctx.BlockTime())
+
+			reserve := types.ConversionReserve()
+			if tc.reserve > 0 {
+				deposit := sdk.NewCoins(sdk.NewInt64Coin(convertDenom, tc.reserve))
+				_, err := suite.msgServer.FundConversionReserve(
+					sdk.WrapSDKContext(suite.ctx), types.NewMsgFundConversionReserve(suite.fundedAccount(deposit), deposit),
+				)
+				suite.Require().NoError(err)
+			}
+			delAddr := suite.delegateAll(valSrcAddr, 1000)[0]
+
+			// 400ulp of weight 0.5 are worth 200uatom of weight 1
+			redelegated := sdk.NewInt64Coin(bondDenom, 400)
+			converted := sdk.NewInt64Coin(convertDenom, 200)
+			cacheCtx, _ := suite.ctx.CacheContext()
+			res, err := suite.msgServer.BeginRedelegate(
+				sdk.WrapSDKContext(cacheCtx), types.NewMsgBeginRedelegate(delAddr, valSrcAddr, valDstAddr, redelegated, tc.convert),
+			)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.ctx = cacheCtx
+			suite.Require().Equal(converted, res.Amount)
+			suite.requireInvariant()
+
+			sdkBondDenom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+			bondTokens, _ := suite.msKeeper.GetDVPairBondTokens(suite.ctx, delAddr, valDstAddr)
+			suite.Require().Equal(converted, bondTokens)
+			sdkBondTokens, _ := suite.msKeeper.GetDVPairSDKBondTokens(suite.ctx, delAddr, valDstAddr)
+			suite.Require().Equal(sdk.NewInt64Coin(sdkBondDenom, 200), sdkBondTokens)
+			sdkBondTokens, _ = suite.msKeeper.GetDVPairSDKBondTokens(suite.ctx, delAddr, valSrcAddr)
+			suite.Require().Equal(sdk.NewInt64Coin(sdkBondDenom, 300), sdkBondTokens)
+
+			dstIntermediaryAccount := types.IntermediaryAccount(delAddr, valDstAddr)
+			suite.Require().Equal(sdk.NewCoins(converted), suite.app.BankKeeper.GetAllBalances(suite.ctx, dstIntermediaryAccount))
+			suite.Require().Equal(
+				sdk.NewCoins(redelegated, sdk.NewInt64Coin(convertDenom, tc.reserve).Sub(converted)),
+				suite.app.BankKeeper.GetAllBalances(suite.ctx, reserve),
+			)
+
+			events := suite.nextBlock(res.CompletionTime)
+			attributes := eventAttributes(events, types.EventTypeCompleteRedelegation)
+			suite.Require().NotNil(attributes)
+			suite.Require().Equal(bondDenom, attributes[types.AttributeKeySrcBondDenom])
+			suite.Require().Equal(convertDenom, attributes[types.AttributeKeyDstBondDenom])
+			suite.requireInvariant()
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestFundConversionReserve() {
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))
+	depositor := suite.fundedAccount(deposit.Add(sdk.NewInt64Coin(convertDenom, 1000)))
+
+	// the reserve only pays bond tokens
+	_, err := suite.msgServer.FundConversionReserve(
+		sdk.WrapSDKContext(suite.ctx), types.NewMsgFundConversionReserve(depositor, sdk.NewCoins(sdk.NewInt64Coin(convertDenom, 1000))),
+	)
+	suite.Require().ErrorIs(err, types.ErrInvalidBondDenom)
+
+	_, err = suite.msgServer.FundConversionReserve(sdk.WrapSDKContext(suite.ctx), types.NewMsgFundConversionReserve(depositor, deposit))
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.ConversionReserve(sdk.WrapSDKContext(suite.ctx), &types.QueryConversionReserveRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.ConversionReserve().String(), res.Address)
+	suite.Require().Equal(deposit, res.Balance)
+}
+
+func (suite *KeeperTestSuite) TestConversionReserveSpendProposal() {
+	handler := multistaking.NewProposalHandler(suite.msKeeper)
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))
+	_, err := suite.msgServer.FundConversionReserve(
+		sdk.WrapSDKContext(suite.ctx), types.NewMsgFundConversionReserve(suite.fundedAccount(deposit), deposit),
+	)
+	suite.Require().NoError(err)
+
+	recipient := sdk.AccAddress([]byte("recipient"))
+	spent := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 400))
+	suite.Require().NoError(handler(suite.ctx, types.NewConversionReserveSpendProposal("title", "description", recipient, spent)))
+	suite.Require().Equal(spent, suite.app.BankKeeper.GetAllBalances(suite.ctx, recipient))
+	suite.Require().Equal(deposit.Sub(spent...), suite.msKeeper.GetConversionReserveBalance(suite.ctx))
+
+	err = handler(suite.ctx, types.NewConversionReserveSpendProposal("title", "description", recipient, deposit))
+	suite.Require().ErrorIs(err, types.ErrInsufficientConversionReserve)
+
+	// module accounts cannot receive the bond tokens
+	distrAccount := suite.app.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+	err = handler(suite.ctx, types.NewConversionReserveSpendProposal("title", "description", distrAccount, spent))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
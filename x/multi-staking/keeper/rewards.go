@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// WithdrawDelegationRewards withdraws the rewards of the sdk delegation of a
+// DV pair and forwards them to the delegator. It returns the rewards.
+func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error) {
+	if _, found := k.GetDVPairBondTokens(ctx, delAddr, valAddr); !found {
+		return nil, distrtypes.ErrEmptyDelegationDistInfo
+	}
+
+	return k.withdrawRewards(ctx, delAddr, valAddr)
+}
+
+// withdrawRewards withdraws the rewards of the sdk delegation of a DV pair.
+// The rewards are paid to the withdraw address of the intermediary account,
+// which follows the withdraw address of the delegator, unless withdraw
+// addresses are disabled, in which case they are forwarded to the withdraw
+// address of the delegator from the intermediary account.
+func (k Keeper) withdrawRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error) {
+	intermediaryAccount := types.IntermediaryAccount(delAddr, valAddr)
+	if err := k.setRewardsWithdrawAddr(ctx, intermediaryAccount, delAddr); err != nil {
+		return nil, err
+	}
+	rewards, err := k.distrKeeper.WithdrawDelegationRewards(ctx, intermediaryAccount, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if !rewards.IsZero() && k.distrKeeper.GetDelegatorWithdrawAddr(ctx, intermediaryAccount).Equals(intermediaryAccount) {
+		withdrawAddr := k.distrKeeper.GetDelegatorWithdrawAddr(ctx, delAddr)
+		if err := k.bankKeeper.SendCoins(ctx, intermediaryAccount, withdrawAddr, rewards); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawRewards,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+		),
+	)
+
+	return rewards, nil
+}
+
+// setRewardsWithdrawAddr sets the withdraw address of an intermediary account
+// to the withdraw address of its delegator, so that the distribution module
+// pays the rewards of the intermediary account to the delegator, or to its
+// custom withdraw address, whenever its sdk delegation changes. The rewards are
+// forwarded by withdrawRewards while withdraw addresses are disabled.
+func (k Keeper) setRewardsWithdrawAddr(ctx sdk.Context, intermediaryAccount, delAddr sdk.AccAddress) error {
+	withdrawAddr := k.distrKeeper.GetDelegatorWithdrawAddr(ctx, delAddr)
+	if k.distrKeeper.GetDelegatorWithdrawAddr(ctx, intermediaryAccount).Equals(withdrawAddr) {
+		return nil
+	}
+
+	err := k.distrKeeper.SetWithdrawAddr(ctx, intermediaryAccount, withdrawAddr)
+	if distrtypes.ErrSetWithdrawAddrDisabled.Is(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// allocateRewards funds the distribution module and allocates the given
+// sdkbond amount to the validator as rewards
+func (suite *KeeperTestSuite) allocateRewards(valAddr sdk.ValAddress, amount int64) {
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(suite.app.StakingKeeper.BondDenom(suite.ctx), amount))
+	funder := suite.fundedAccount(rewards)
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromAccountToModule(suite.ctx, funder, distrtypes.ModuleName, rewards))
+
+	validator, _ := suite.app.StakingKeeper.GetValidator(suite.ctx, valAddr)
+	suite.app.DistrKeeper.AllocateTokensToValidator(suite.ctx, validator, sdk.NewDecCoinsFromCoins(rewards...))
+}
+
+func (suite *KeeperTestSuite) TestWithdrawDelegatorReward() {
+	testCases := []struct {
+		name                string
+		withdrawAddrEnabled bool
+	}{
+		{
+			name:                "rewards are paid to the withdraw address",
+			withdrawAddrEnabled: true,
+		},
+		{
+			name:                "rewards are forwarded from the intermediary account",
+			withdrawAddrEnabled: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.disableInflation()
+			params := suite.app.DistrKeeper.GetParams(suite.ctx)
+			params.WithdrawAddrEnabled = tc.withdrawAddrEnabled
+			suite.app.DistrKeeper.SetParams(suite.ctx, params)
+
+			valAddr := suite.validator.GetOperator()
+			sdkBondDenom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+			delegated := sdk.NewInt64Coin(bondDenom, 1000)
+			delAddr := suite.fundedAccount(sdk.NewCoins(delegated))
+			intermediaryAccount := types.IntermediaryAccount(delAddr, valAddr)
+
+			_, err := suite.msgServer.Delegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgDelegate(delAddr, valAddr, delegated))
+			suite.Require().NoError(err)
+			withdrawAddr := suite.app.DistrKeeper.GetDelegatorWithdrawAddr(suite.ctx, intermediaryAccount)
+			if tc.withdrawAddrEnabled {
+				suite.Require().Equal(delAddr, withdrawAddr)
+			} else {
+				suite.Require().Equal(intermediaryAccount, withdrawAddr)
+			}
+
+			// the distribution module pays no rewards in the block a
+			// delegation starts
+			suite.nextBlock(suite.ctx.BlockTime())
+			suite.allocateRewards(valAddr, 1_000_000)
+			res, err := suite.msgServer.WithdrawDelegatorReward(
+				sdk.WrapSDKContext(suite.ctx), types.NewMsgWithdrawDelegatorReward(delAddr, valAddr),
+			)
+			suite.Require().NoError(err)
+			suite.Require().True(res.Amount.AmountOf(sdkBondDenom).IsPositive())
+			suite.Require().Equal(res.Amount, suite.app.BankKeeper.GetAllBalances(suite.ctx, delAddr))
+			suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, intermediaryAccount).IsEqual(sdk.NewCoins(delegated)))
+
+			// the rewards are forwarded on every change of the delegation too
+			suite.nextBlock(suite.ctx.BlockTime())
+			suite.allocateRewards(valAddr, 1_000_000)
+			undelegated := sdk.NewInt64Coin(bondDenom, 500)
+			_, err = suite.msgServer.Undelegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgUndelegate(delAddr, valAddr, undelegated))
+			suite.Require().NoError(err)
+			balance := suite.app.BankKeeper.GetBalance(suite.ctx, delAddr, sdkBondDenom)
+			suite.Require().True(balance.Amount.GT(res.Amount.AmountOf(sdkBondDenom)))
+			suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, intermediaryAccount).IsEqual(sdk.NewCoins(delegated)))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestWithdrawDelegatorRewardWithoutDelegation() {
+	delAddr := suite.fundedAccount(sdk.NewCoins())
+	_, err := suite.msgServer.WithdrawDelegatorReward(
+		sdk.WrapSDKContext(suite.ctx), types.NewMsgWithdrawDelegatorReward(delAddr, suite.validator.GetOperator()),
+	)
+	suite.Require().ErrorIs(err, distrtypes.ErrEmptyDelegationDistInfo)
+}
@@ -0,0 +1,234 @@
+package keeper
+
+import (
+	"bytes"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// changeBondTokenWeight sets the weight of a bond denom and starts the job
+// reweighting its DV pairs. A job in progress keeps its position: it goes on
+// with the new weight and wraps around to the DV pairs it already scanned, so
+// that frequent weight changes do not restart it over and over.
+func (k Keeper) changeBondTokenWeight(ctx sdk.Context, denom string, weight sdk.Dec) {
+	k.SetBondTokenWeight(ctx, denom, weight)
+
+	reweighting, _ := k.GetReweighting(ctx, denom)
+	k.SetReweighting(ctx, denom, types.Reweighting{
+		BondTokenWeight: weight,
+		NextDvPair:      reweighting.NextDvPair,
+		StopDvPair:      reweighting.NextDvPair,
+	})
+}
+
+// ProcessReweightings advances the reweighting jobs of the bond denoms whose
+// weight changed. Each job scans the DV pairs in key order and mints or
+// unbonds sdkbond tokens for the ones of its denom, so that their sdkbond
+// tokens match the new weight. At most ReweightingBatchSize DV pairs are
+// scanned per block, over all the jobs. A pass leaving DV pairs that could
+// not be reweighted is followed by another one, after a delay doubling with
+// each such pass up to WeightEpochLength blocks.
+func (k Keeper) ProcessReweightings(ctx sdk.Context) {
+	var denoms []string
+	k.IterateReweightings(ctx, func(denom string, _ types.Reweighting) bool {
+		denoms = append(denoms, denom)
+		return false
+	})
+
+	budget := int(k.ReweightingBatchSize(ctx))
+	for _, denom := range denoms {
+		if budget == 0 {
+			return
+		}
+
+		reweighting, _ := k.GetReweighting(ctx, denom)
+		if ctx.BlockHeight() < reweighting.NextPassHeight {
+			continue
+		}
+
+		// a pass which wrapped around ends at its stop DV pair
+		var end []byte
+		wrapped := len(reweighting.StopDvPair) > 0 && bytes.Compare(reweighting.NextDvPair, reweighting.StopDvPair) < 0
+		if wrapped {
+			end = reweighting.StopDvPair
+		}
+
+		keys, pairs := k.dvPairBondTokensFrom(ctx, reweighting.NextDvPair, end, budget+1)
+		batch := len(pairs)
+		if batch > budget {
+			batch = budget
+		}
+		budget -= batch
+
+		for _, pair := range pairs[:batch] {
+			if pair.bondTokens.Denom != denom {
+				continue
+			}
+
+			cacheCtx, write := ctx.CacheContext()
+			if err := k.reweightDVPair(cacheCtx, pair.delAddr, pair.valAddr, pair.bondTokens, reweighting.BondTokenWeight); err != nil {
+				k.Logger(ctx).Info(
+					"failed to reweight DV pair", "delegator", pair.delAddr, "validator", pair.valAddr,
+					"amount", pair.bondTokens, "err", err,
+				)
+				reweighting.Retry = true
+				continue
+			}
+			write()
+		}
+
+		switch {
+		case len(pairs) > batch:
+			reweighting.NextDvPair = keys[batch]
+			k.SetReweighting(ctx, denom, reweighting)
+		case len(reweighting.StopDvPair) > 0 && !wrapped:
+			reweighting.NextDvPair = nil
+			k.SetReweighting(ctx, denom, reweighting)
+		case reweighting.Retry:
+			reweighting.NextDvPair = nil
+			reweighting.StopDvPair = nil
+			reweighting.Retry = false
+			reweighting.NextPassHeight = ctx.BlockHeight() + k.reweightingRetryDelay(ctx, reweighting.Retries)
+			reweighting.Retries++
+			k.SetReweighting(ctx, denom, reweighting)
+		default:
+			k.DeleteReweighting(ctx, denom)
+		}
+	}
+}
+
+// reweightingRetryDelay returns the number of blocks a reweighting job waits
+// before a pass retrying its DV pairs, given the number of passes which left
+// DV pairs to retry before. It doubles from one block up to WeightEpochLength.
+func (k Keeper) reweightingRetryDelay(ctx sdk.Context, retries uint32) int64 {
+	maxDelay := int64(k.WeightEpochLength(ctx))
+	if retries >= 62 || int64(1)<<retries > maxDelay {
+		return maxDelay
+	}
+	return int64(1) << retries
+}
+
+// reweightDVPair mints or unbonds sdkbond tokens for a DV pair so that its
+// sdkbond tokens are its bond tokens times the weight. A DV pair worth no
+// sdkbond tokens at the new weight is undelegated.
+func (k Keeper) reweightDVPair(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, bondTokens sdk.Coin, weight sdk.Dec) error {
+	sdkBondAmount, target, err := k.syncDVPairSDKBondTokens(ctx, delAddr, valAddr, bondTokens, weight)
+	if err != nil || target.Equal(sdkBondAmount) {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReweight,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, bondTokens.String()),
+			sdk.NewAttribute(types.AttributeKeyOldSDKBond, sdkBondAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyNewSDKBond, target.String()),
+		),
+	)
+
+	return nil
+}
+
+// syncDVPairSDKBondTokens mints or unbonds sdkbond tokens for a DV pair so that
+// its sdkbond tokens are its bond tokens times the weight, undelegating the DV
+// pair if that is zero. It returns the sdkbond amount of the DV pair before and
+// after.
+func (k Keeper) syncDVPairSDKBondTokens(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, bondTokens sdk.Coin, weight sdk.Dec,
+) (math.Int, math.Int, error) {
+	sdkBondTokens, found := k.GetDVPairSDKBondTokens(ctx, delAddr, valAddr)
+	if !found {
+		sdkBondTokens = sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), math.ZeroInt())
+	}
+
+	target := weight.MulInt(bondTokens.Amount).TruncateInt()
+	intermediaryAccount := types.IntermediaryAccount(delAddr, valAddr)
+
+	switch {
+	case target.Equal(sdkBondTokens.Amount):
+		return sdkBondTokens.Amount, target, nil
+
+	case target.IsZero():
+		if _, err := k.Undelegate(ctx, delAddr, valAddr, bondTokens); err != nil {
+			return math.Int{}, math.Int{}, err
+		}
+
+	case target.GT(sdkBondTokens.Amount):
+		validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if !found {
+			return math.Int{}, math.Int{}, stakingtypes.ErrNoValidatorFound
+		}
+
+		if _, err := k.withdrawRewards(ctx, delAddr, valAddr); err != nil {
+			return math.Int{}, math.Int{}, err
+		}
+		minted, err := k.mintSDKBondTokens(ctx, intermediaryAccount, target.Sub(sdkBondTokens.Amount))
+		if err != nil {
+			return math.Int{}, math.Int{}, err
+		}
+		if _, err := k.stakingKeeper.Delegate(ctx, intermediaryAccount, minted.Amount, stakingtypes.Unbonded, validator, true); err != nil {
+			return math.Int{}, math.Int{}, err
+		}
+		k.SetDVPairSDKBondTokens(ctx, delAddr, valAddr, sdkBondTokens.Add(minted))
+
+	default:
+		delegation, found := k.stakingKeeper.GetDelegation(ctx, intermediaryAccount, valAddr)
+		if !found {
+			return math.Int{}, math.Int{}, stakingtypes.ErrNoDelegation
+		}
+
+		if _, err := k.withdrawRewards(ctx, delAddr, valAddr); err != nil {
+			return math.Int{}, math.Int{}, err
+		}
+		// the unbonded shares are pro-rata to the sdkbond tokens, so that the
+		// slashing of the sdk delegation is borne by the unbonding tokens too
+		unbonded := sdkBondTokens.SubAmount(target)
+		shares := delegation.Shares.MulInt(unbonded.Amount).QuoInt(sdkBondTokens.Amount)
+		completionTime, err := k.stakingKeeper.Undelegate(ctx, intermediaryAccount, valAddr, shares)
+		if err != nil {
+			return math.Int{}, math.Int{}, err
+		}
+		k.SetDVPairSDKBondTokens(ctx, delAddr, valAddr, sdkBondTokens.Sub(unbonded))
+		k.addDVPairUnbondingTokens(ctx, delAddr, valAddr, completionTime, sdk.NewCoin(bondTokens.Denom, math.ZeroInt()), unbonded)
+	}
+
+	return sdkBondTokens.Amount, target, nil
+}
+
+// dvPairBondTokens is the bond tokens record of a DV pair
+type dvPairBondTokens struct {
+	delAddr    sdk.AccAddress
+	valAddr    sdk.ValAddress
+	bondTokens sdk.Coin
+}
+
+// dvPairBondTokensFrom returns the keys and the bond tokens of at most limit
+// DV pairs, starting from the given DV pair key and ending before the end key,
+// or at the last DV pair if it is nil
+func (k Keeper) dvPairBondTokensFrom(ctx sdk.Context, start, end []byte, limit int) ([][]byte, []dvPairBondTokens) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DVPairBondTokenKey)
+	iterator := store.Iterator(start, end)
+	defer iterator.Close()
+
+	var (
+		keys  [][]byte
+		pairs []dvPairBondTokens
+	)
+	for ; iterator.Valid() && len(pairs) < limit; iterator.Next() {
+		var bondTokens sdk.Coin
+		k.cdc.MustUnmarshal(iterator.Value(), &bondTokens)
+		delAddr, valAddr := types.ParseDVPairKey(iterator.Key())
+
+		keys = append(keys, iterator.Key())
+		pairs = append(pairs, dvPairBondTokens{delAddr, valAddr, bondTokens})
+	}
+
+	return keys, pairs
+}
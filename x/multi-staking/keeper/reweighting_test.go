@@ -0,0 +1,303 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	multistaking "github.com/notional-labs/multi-staking-module/x/multi-staking"
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/keeper"
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// changeBondTokenWeight executes a passed change bond token weight proposal
+func (suite *KeeperTestSuite) changeBondTokenWeight(denom string, weight sdk.Dec) {
+	handler := multistaking.NewProposalHandler(suite.msKeeper)
+	suite.Require().NoError(handler(suite.ctx, types.NewChangeBondTokenWeightProposal("title", "description", denom, weight)))
+}
+
+// delegateAll creates a funded delegator for each amount and delegates it to the validator
+func (suite *KeeperTestSuite) delegateAll(valAddr sdk.ValAddress, amounts ...int64) []sdk.AccAddress {
+	delegators := make([]sdk.AccAddress, len(amounts))
+	for i, amount := range amounts {
+		delegated := sdk.NewInt64Coin(bondDenom, amount)
+		delegators[i] = suite.fundedAccount(sdk.NewCoins(delegated))
+		_, err := suite.msgServer.Delegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgDelegate(delegators[i], valAddr, delegated))
+		suite.Require().NoError(err)
+	}
+	return delegators
+}
+
+func (suite *KeeperTestSuite) requireInvariant() {
+	msg, broken := keeper.AllInvariants(suite.msKeeper)(suite.ctx)
+	suite.Require().False(broken, msg)
+}
+
+func countEventType(events []abci.Event, eventType string) int {
+	count := 0
+	for _, event := range events {
+		if event.Type == eventType {
+			count++
+		}
+	}
+	return count
+}
+
+func (suite *KeeperTestSuite) TestReweightingIncrease() {
+	suite.disableInflation()
+	valAddr := suite.validator.GetOperator()
+	amounts := []int64{1000, 333, 7}
+	delegators := suite.delegateAll(valAddr, amounts...)
+	validator, _ := suite.app.StakingKeeper.GetValidator(suite.ctx, valAddr)
+	tokens := validator.Tokens
+
+	newWeight := sdk.MustNewDecFromStr("0.8")
+	suite.changeBondTokenWeight(bondDenom, newWeight)
+	events := suite.nextBlock(suite.ctx.BlockTime())
+	suite.Require().Equal(len(amounts), countEventType(events, types.EventTypeReweight))
+	_, found := suite.msKeeper.GetReweighting(suite.ctx, bondDenom)
+	suite.Require().False(found)
+
+	minted := sdk.ZeroInt()
+	for i, delAddr := range delegators {
+		expected := newWeight.MulInt64(amounts[i]).TruncateInt()
+		sdkBondTokens, _ := suite.msKeeper.GetDVPairSDKBondTokens(suite.ctx, delAddr, valAddr)
+		suite.Require().Equal(expected, sdkBondTokens.Amount)
+		minted = minted.Add(expected).Sub(bondWeight.MulInt64(amounts[i]).TruncateInt())
+
+		intermediaryAccount := types.IntermediaryAccount(delAddr, valAddr)
+		delegation, _ := suite.app.StakingKeeper.GetDelegation(suite.ctx, intermediaryAccount, valAddr)
+		suite.Require().Equal(expected, validator.TokensFromShares(delegation.Shares).TruncateInt())
+	}
+	validator, _ = suite.app.StakingKeeper.GetValidator(suite.ctx, valAddr)
+	suite.Require().Equal(tokens.Add(minted), validator.Tokens)
+	suite.requireInvariant()
+
+	// the reweighted delegations are unbonded in full
+	for i, delAddr := range delegators {
+		_, err := suite.msgServer.Undelegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgUndelegate(delAddr, valAddr, sdk.NewInt64Coin(bondDenom, amounts[i])))
+		suite.Require().NoError(err)
+	}
+	suite.nextBlock(suite.ctx.BlockTime().Add(suite.app.StakingKeeper.UnbondingTime(suite.ctx)))
+	for i, delAddr := range delegators {
+		suite.Require().Equal(sdk.NewInt64Coin(bondDenom, amounts[i]), suite.app.BankKeeper.GetBalance(suite.ctx, delAddr, bondDenom))
+	}
+}
+
+func (suite *KeeperTestSuite) TestReweightingDecrease() {
+	suite.disableInflation()
+	valAddr := suite.validator.GetOperator()
+	sdkBondDenom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	// the last delegation is worth no sdkbond tokens at the new weight
+	amounts := []int64{1000, 333, 3}
+	delegators := suite.delegateAll(valAddr, amounts...)
+	sdkBondSupply := suite.app.BankKeeper.GetSupply(suite.ctx, sdkBondDenom)
+
+	newWeight := sdk.MustNewDecFromStr("0.2")
+	suite.changeBondTokenWeight(bondDenom, newWeight)
+	events := suite.nextBlock(suite.ctx.BlockTime())
+	suite.Require().Equal(len(amounts), countEventType(events, types.EventTypeReweight))
+	suite.requireInvariant()
+
+	unbonded := sdk.ZeroInt()
+	for i, delAddr := range delegators {
+		expected := newWeight.MulInt64(amounts[i]).TruncateInt()
+		unbonded = unbonded.Add(bondWeight.MulInt64(amounts[i]).TruncateInt()).Sub(expected)
+		if expected.IsZero() {
+			_, found := suite.msKeeper.GetDVPairBondTokens(suite.ctx, delAddr, valAddr)
+			suite.Require().False(found)
+			continue
+		}
+
+		sdkBondTokens, _ := suite.msKeeper.GetDVPairSDKBondTokens(suite.ctx, delAddr, valAddr)
+		suite.Require().Equal(expected, sdkBondTokens.Amount)
+		bondTokens, _ := suite.msKeeper.GetDVPairBondTokens(suite.ctx, delAddr, valAddr)
+		suite.Require().Equal(sdk.NewInt64Coin(bondDenom, amounts[i]), bondTokens)
+	}
+
+	// the unbonded sdkbond tokens are burned once the unbonding completes,
+	// while the bond tokens stay locked, except for the undelegated pair
+	suite.nextBlock(suite.ctx.BlockTime().Add(suite.app.StakingKeeper.UnbondingTime(suite.ctx)))
+	suite.Require().Equal(sdkBondSupply.SubAmount(unbonded), suite.app.BankKeeper.GetSupply(suite.ctx, sdkBondDenom))
+	for i, delAddr := range delegators {
+		intermediaryAccount := types.IntermediaryAccount(delAddr, valAddr)
+		if i == len(delegators)-1 {
+			suite.Require().Equal(sdk.NewInt64Coin(bondDenom, amounts[i]), suite.app.BankKeeper.GetBalance(suite.ctx, delAddr, bondDenom))
+			continue
+		}
+		suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, delAddr, bondDenom).IsZero())
+		suite.Require().Equal(sdk.NewInt64Coin(bondDenom, amounts[i]), suite.app.BankKeeper.GetBalance(suite.ctx, intermediaryAccount, bondDenom))
+	}
+	suite.requireInvariant()
+}
+
+func (suite *KeeperTestSuite) TestReweightingSpreadAcrossBlocks() {
+	suite.disableInflation()
+	valAddr := suite.validator.GetOperator()
+	delegators := suite.delegateAll(valAddr, 100, 200, 300, 400, 500)
+
+	params := suite.msKeeper.GetParams(suite.ctx)
+	params.ReweightingBatchSize = 2
+	suite.msKeeper.SetParams(suite.ctx, params)
+
+	suite.changeBondTokenWeight(bondDenom, sdk.OneDec())
+	for _, expected := range []int{2, 2, 1} {
+		_, found := suite.msKeeper.GetReweighting(suite.ctx, bondDenom)
+		suite.Require().True(found)
+
+		events := suite.nextBlock(suite.ctx.BlockTime())
+		suite.Require().Equal(expected, countEventType(events, types.EventTypeReweight))
+		// the DV pairs left to reweight are skipped by the invariant
+		suite.requireInvariant()
+	}
+	_, found := suite.msKeeper.GetReweighting(suite.ctx, bondDenom)
+	suite.Require().False(found)
+
+	for _, delAddr := range delegators {
+		bondTokens, _ := suite.msKeeper.GetDVPairBondTokens(suite.ctx, delAddr, valAddr)
+		sdkBondTokens, _ := suite.msKeeper.GetDVPairSDKBondTokens(suite.ctx, delAddr, valAddr)
+		suite.Require().Equal(bondTokens.Amount, sdkBondTokens.Amount)
+	}
+}
+
+func (suite *KeeperTestSuite) TestReweightingKeepsProgressAcrossWeightChanges() {
+	suite.disableInflation()
+	valAddr := suite.validator.GetOperator()
+	delegators := suite.delegateAll(valAddr, 100, 200, 300, 400, 500)
+
+	params := suite.msKeeper.GetParams(suite.ctx)
+	params.ReweightingBatchSize = 2
+	suite.msKeeper.SetParams(suite.ctx, params)
+
+	suite.changeBondTokenWeight(bondDenom, sdk.OneDec())
+	suite.Require().Equal(2, countEventType(suite.nextBlock(suite.ctx.BlockTime()), types.EventTypeReweight))
+	reweighting, _ := suite.msKeeper.GetReweighting(suite.ctx, bondDenom)
+
+	// the new weight goes on from the DV pair the job was at, then wraps around
+	// to the DV pairs reweighted to the former weight
+	weight := sdk.MustNewDecFromStr("0.8")
+	suite.changeBondTokenWeight(bondDenom, weight)
+	changed, _ := suite.msKeeper.GetReweighting(suite.ctx, bondDenom)
+	suite.Require().Equal(weight, changed.BondTokenWeight)
+	suite.Require().Equal(reweighting.NextDvPair, changed.NextDvPair)
+	suite.Require().Equal(reweighting.NextDvPair, changed.StopDvPair)
+
+	for _, expected := range []int{2, 1, 2} {
+		_, found := suite.msKeeper.GetReweighting(suite.ctx, bondDenom)
+		suite.Require().True(found)
+
+		events := suite.nextBlock(suite.ctx.BlockTime())
+		suite.Require().Equal(expected, countEventType(events, types.EventTypeReweight))
+		suite.requireInvariant()
+	}
+	_, found := suite.msKeeper.GetReweighting(suite.ctx, bondDenom)
+	suite.Require().False(found)
+
+	for _, delAddr := range delegators {
+		bondTokens, _ := suite.msKeeper.GetDVPairBondTokens(suite.ctx, delAddr, valAddr)
+		sdkBondTokens, _ := suite.msKeeper.GetDVPairSDKBondTokens(suite.ctx, delAddr, valAddr)
+		suite.Require().Equal(weight.MulInt(bondTokens.Amount).TruncateInt(), sdkBondTokens.Amount)
+	}
+}
+
+func (suite *KeeperTestSuite) TestUndelegateDuringReweighting() {
+	suite.disableInflation()
+	valAddr := suite.validator.GetOperator()
+	delegators := suite.delegateAll(valAddr, 1000, 1000, 1000)
+
+	params := suite.msKeeper.GetParams(suite.ctx)
+	params.ReweightingBatchSize = 1
+	suite.msKeeper.SetParams(suite.ctx, params)
+
+	suite.changeBondTokenWeight(bondDenom, sdk.OneDec())
+	suite.Require().Equal(1, countEventType(suite.nextBlock(suite.ctx.BlockTime()), types.EventTypeReweight))
+
+	// a DV pair the job has not reached yet still has the sdkbond tokens of the
+	// former weight
+	var delAddr sdk.AccAddress
+	for _, d := range delegators {
+		if sdkBondTokens, _ := suite.msKeeper.GetDVPairSDKBondTokens(suite.ctx, d, valAddr); sdkBondTokens.Amount.Equal(sdk.NewInt(500)) {
+			delAddr = d
+			break
+		}
+	}
+	suite.Require().NotNil(delAddr)
+
+	// it is synced to the new weight before part of it is undelegated
+	res, err := suite.msgServer.Undelegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgUndelegate(delAddr, valAddr, sdk.NewInt64Coin(bondDenom, 600)))
+	suite.Require().NoError(err)
+	sdkBondTokens, _ := suite.msKeeper.GetDVPairSDKBondTokens(suite.ctx, delAddr, valAddr)
+	suite.Require().Equal(sdk.NewInt(400), sdkBondTokens.Amount)
+	unbonding, found := suite.msKeeper.GetDVPairUnbondingTokens(suite.ctx, delAddr, valAddr, res.CompletionTime)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(600), unbonding.SDKBondTokens.Amount)
+	suite.requireInvariant()
+
+	for i := 0; i < len(delegators); i++ {
+		suite.nextBlock(suite.ctx.BlockTime())
+	}
+	_, found = suite.msKeeper.GetReweighting(suite.ctx, bondDenom)
+	suite.Require().False(found)
+	for _, d := range delegators {
+		bondTokens, _ := suite.msKeeper.GetDVPairBondTokens(suite.ctx, d, valAddr)
+		sdkBondTokens, _ := suite.msKeeper.GetDVPairSDKBondTokens(suite.ctx, d, valAddr)
+		suite.Require().Equal(bondTokens.Amount, sdkBondTokens.Amount)
+	}
+	suite.requireInvariant()
+}
+
+func (suite *KeeperTestSuite) TestReweightingRetriesFailedDVPairs() {
+	suite.disableInflation()
+	valAddr := suite.validator.GetOperator()
+	delegated := int64(1000)
+	delAddr := suite.delegateAll(valAddr, delegated)[0]
+
+	// with a single unbonding entry allowed, the unbonding of the reweighting
+	// has to wait for the ongoing unbonding to complete
+	stakingParams := suite.app.StakingKeeper.GetParams(suite.ctx)
+	stakingParams.MaxEntries = 1
+	suite.app.StakingKeeper.SetParams(suite.ctx, stakingParams)
+	res, err := suite.msgServer.Undelegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgUndelegate(delAddr, valAddr, sdk.NewInt64Coin(bondDenom, 400)))
+	suite.Require().NoError(err)
+
+	suite.changeBondTokenWeight(bondDenom, sdk.MustNewDecFromStr("0.1"))
+	events := suite.nextBlock(suite.ctx.BlockTime())
+	suite.Require().Zero(countEventType(events, types.EventTypeReweight))
+	reweighting, found := suite.msKeeper.GetReweighting(suite.ctx, bondDenom)
+	suite.Require().True(found)
+	suite.Require().Nil(reweighting.NextDvPair)
+	suite.Require().Equal(uint32(1), reweighting.Retries)
+	suite.Require().Equal(suite.ctx.BlockHeight()+1, reweighting.NextPassHeight)
+
+	// each failed pass doubles the delay before the next one
+	suite.nextBlock(suite.ctx.BlockTime())
+	reweighting, _ = suite.msKeeper.GetReweighting(suite.ctx, bondDenom)
+	suite.Require().Equal(uint32(2), reweighting.Retries)
+	suite.Require().Equal(suite.ctx.BlockHeight()+2, reweighting.NextPassHeight)
+	suite.nextBlock(suite.ctx.BlockTime())
+	skipped, _ := suite.msKeeper.GetReweighting(suite.ctx, bondDenom)
+	suite.Require().Equal(reweighting, skipped)
+
+	events = suite.nextBlock(res.CompletionTime)
+	suite.Require().Equal(1, countEventType(events, types.EventTypeReweight))
+	_, found = suite.msKeeper.GetReweighting(suite.ctx, bondDenom)
+	suite.Require().False(found)
+	sdkBondTokens, _ := suite.msKeeper.GetDVPairSDKBondTokens(suite.ctx, delAddr, valAddr)
+	suite.Require().Equal(sdk.NewInt(60), sdkBondTokens.Amount)
+	suite.requireInvariant()
+}
+
+func (suite *KeeperTestSuite) TestSDKBondTokensInvariant() {
+	valAddr := suite.validator.GetOperator()
+	delAddr := suite.delegateAll(valAddr, 1000)[0]
+	suite.requireInvariant()
+
+	sdkBondTokens, _ := suite.msKeeper.GetDVPairSDKBondTokens(suite.ctx, delAddr, valAddr)
+	suite.msKeeper.SetDVPairSDKBondTokens(suite.ctx, delAddr, valAddr, sdkBondTokens.AddAmount(sdk.OneInt()))
+	_, broken := keeper.SDKBondTokensInvariant(suite.msKeeper)(suite.ctx)
+	suite.Require().True(broken)
+
+	// a pending reweighting job covers the mismatch
+	suite.msKeeper.SetReweighting(suite.ctx, bondDenom, types.Reweighting{BondTokenWeight: bondWeight})
+	msg, broken := keeper.SDKBondTokensInvariant(suite.msKeeper)(suite.ctx)
+	suite.Require().False(broken, msg)
+}
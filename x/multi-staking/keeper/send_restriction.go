@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// ValidateSendCoins returns an error if users may not transfer the coins. The
+// sdkbond tokens are only transferable if SDKBondTransferable is set.
+func (k Keeper) ValidateSendCoins(ctx sdk.Context, coins ...sdk.Coin) error {
+	sdkBondDenom := k.stakingKeeper.BondDenom(ctx)
+	for _, coin := range coins {
+		if coin.Denom == sdkBondDenom && !k.SDKBondTransferable(ctx) {
+			return sdkerrors.Wrapf(types.ErrSDKBondNotTransferable, "%s", coin.Denom)
+		}
+	}
+	return nil
+}
+
+// ValidateSender returns an error if users may not send the coins from the
+// address. The sdkbond tokens of the intermediary accounts back their locked
+// bond tokens, so they never leave them but in the staking flows, even when
+// SDKBondTransferable is set.
+func (k Keeper) ValidateSender(ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) error {
+	sdkBondDenom := k.stakingKeeper.BondDenom(ctx)
+	if types.IsIntermediaryAccount(addr) && !coins.AmountOf(sdkBondDenom).IsZero() {
+		return sdkerrors.Wrapf(types.ErrSDKBondNotTransferable, "%s of intermediary account %s", sdkBondDenom, addr)
+	}
+	return nil
+}
+
+// ValidateRecipient returns an error if users may not send coins to the
+// address. The intermediary accounts are blocked like the module accounts,
+// as the coins sent to them could never be withdrawn, including those of the
+// DV pairs that have not delegated yet.
+func (k Keeper) ValidateRecipient(_ sdk.Context, addr sdk.AccAddress) error {
+	if types.IsIntermediaryAccount(addr) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", addr)
+	}
+	return nil
+}
+
+var _ bankkeeper.Keeper = SendRestrictedBankKeeper{}
+
+// SendRestrictedBankKeeper wraps the bank keeper of the modules that move
+// coins on behalf of users, the bank msg server and the IBC transfer keeper.
+// It refuses to send the sdkbond denom unless SDKBondTransferable is set, which
+// the transfer keeper checks before escrowing a token, to send the sdkbond
+// tokens of the intermediary accounts, and to pay the intermediary accounts.
+// The intermediary accounts are derived for every DV pair, so unlike the module
+// accounts they cannot be listed in the blocked addresses of the bank keeper,
+// and are recognized by their IntermediaryAccountPrefix instead.
+//
+// The keepers of the staking flows use the bank keeper itself, so the
+// multi-staking, staking and distribution modules still move the sdkbond
+// tokens from and to the intermediary accounts.
+type SendRestrictedBankKeeper struct {
+	bankkeeper.Keeper
+
+	k Keeper
+}
+
+// NewSendRestrictedBankKeeper wraps the bank keeper with the send
+// restrictions of the multi-staking module.
+func NewSendRestrictedBankKeeper(bk bankkeeper.Keeper, k Keeper) SendRestrictedBankKeeper {
+	return SendRestrictedBankKeeper{Keeper: bk, k: k}
+}
+
+// IsSendEnabledCoins returns an error if one of the coins may not be sent.
+func (bk SendRestrictedBankKeeper) IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error {
+	if err := bk.k.ValidateSendCoins(ctx, coins...); err != nil {
+		return err
+	}
+	return bk.Keeper.IsSendEnabledCoins(ctx, coins...)
+}
+
+// IsSendEnabledCoin returns true if the coin may be sent.
+func (bk SendRestrictedBankKeeper) IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool {
+	return bk.k.ValidateSendCoins(ctx, coin) == nil && bk.Keeper.IsSendEnabledCoin(ctx, coin)
+}
+
+// SendCoins sends coins between accounts, refusing to pay an intermediary
+// account or to send its sdkbond tokens.
+func (bk SendRestrictedBankKeeper) SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := bk.k.ValidateSender(ctx, fromAddr, amt); err != nil {
+		return err
+	}
+	if err := bk.k.ValidateRecipient(ctx, toAddr); err != nil {
+		return err
+	}
+	return bk.Keeper.SendCoins(ctx, fromAddr, toAddr, amt)
+}
+
+// InputOutputCoins performs a multi-send, refusing to pay an intermediary
+// account or to send its sdkbond tokens.
+func (bk SendRestrictedBankKeeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
+	for _, in := range inputs {
+		fromAddr, err := sdk.AccAddressFromBech32(in.Address)
+		if err != nil {
+			return err
+		}
+		if err := bk.k.ValidateSender(ctx, fromAddr, in.Coins); err != nil {
+			return err
+		}
+	}
+	for _, out := range outputs {
+		toAddr, err := sdk.AccAddressFromBech32(out.Address)
+		if err != nil {
+			return err
+		}
+		if err := bk.k.ValidateRecipient(ctx, toAddr); err != nil {
+			return err
+		}
+	}
+	return bk.Keeper.InputOutputCoins(ctx, inputs, outputs)
+}
+
+// SendCoinsFromModuleToAccount sends coins from a module account, refusing to
+// pay an intermediary account.
+func (bk SendRestrictedBankKeeper) SendCoinsFromModuleToAccount(
+	ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins,
+) error {
+	if err := bk.k.ValidateRecipient(ctx, recipientAddr); err != nil {
+		return err
+	}
+	return bk.Keeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+}
+
+// SendCoinsFromAccountToModule sends coins to a module account, refusing to
+// send the sdkbond tokens of an intermediary account.
+func (bk SendRestrictedBankKeeper) SendCoinsFromAccountToModule(
+	ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins,
+) error {
+	if err := bk.k.ValidateSender(ctx, senderAddr, amt); err != nil {
+		return err
+	}
+	return bk.Keeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+}
@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+func (suite *KeeperTestSuite) TestSendRestrictions() {
+	_, _, recipient := testdata.KeyTestPubAddr()
+	sdkBondDenom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	sdkBond := sdk.NewInt64Coin(sdkBondDenom, 1000)
+	bond := sdk.NewInt64Coin(bondDenom, 1000)
+
+	delAddr := suite.delegateAll(suite.validator.GetOperator(), 1000)[0]
+	intermediaryAccount := types.IntermediaryAccount(delAddr, suite.validator.GetOperator())
+
+	testCases := []struct {
+		name      string
+		msg       func(sender sdk.AccAddress) sdk.Msg
+		expectErr error
+	}{
+		{
+			name: "send bond tokens",
+			msg: func(sender sdk.AccAddress) sdk.Msg {
+				return banktypes.NewMsgSend(sender, recipient, sdk.NewCoins(bond))
+			},
+		},
+		{
+			name: "send sdkbond tokens",
+			msg: func(sender sdk.AccAddress) sdk.Msg {
+				return banktypes.NewMsgSend(sender, recipient, sdk.NewCoins(bond, sdkBond))
+			},
+			expectErr: types.ErrSDKBondNotTransferable,
+		},
+		{
+			name: "send to an intermediary account",
+			msg: func(sender sdk.AccAddress) sdk.Msg {
+				return banktypes.NewMsgSend(sender, intermediaryAccount, sdk.NewCoins(bond))
+			},
+			expectErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name: "send to the intermediary account of a DV pair without delegation",
+			msg: func(sender sdk.AccAddress) sdk.Msg {
+				return banktypes.NewMsgSend(sender, types.IntermediaryAccount(sender, suite.validator.GetOperator()), sdk.NewCoins(bond))
+			},
+			expectErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name: "multi-send sdkbond tokens",
+			msg: func(sender sdk.AccAddress) sdk.Msg {
+				return banktypes.NewMsgMultiSend(
+					[]banktypes.Input{banktypes.NewInput(sender, sdk.NewCoins(sdkBond))},
+					[]banktypes.Output{banktypes.NewOutput(recipient, sdk.NewCoins(sdkBond))},
+				)
+			},
+			expectErr: types.ErrSDKBondNotTransferable,
+		},
+		{
+			name: "multi-send to an intermediary account",
+			msg: func(sender sdk.AccAddress) sdk.Msg {
+				return banktypes.NewMsgMultiSend(
+					[]banktypes.Input{banktypes.NewInput(sender, sdk.NewCoins(bond.Add(bond)))},
+					[]banktypes.Output{
+						banktypes.NewOutput(recipient, sdk.NewCoins(bond)),
+						banktypes.NewOutput(intermediaryAccount, sdk.NewCoins(bond)),
+					},
+				)
+			},
+			expectErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name: "ibc transfer sdkbond tokens",
+			msg: func(sender sdk.AccAddress) sdk.Msg {
+				return ibctransfertypes.NewMsgTransfer(
+					ibctransfertypes.PortID, "channel-0", sdkBond, sender.String(), recipient.String(),
+					clienttypes.NewHeight(1, 100), 0, "",
+				)
+			},
+			expectErr: ibctransfertypes.ErrSendDisabled,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			sender := suite.fundedAccount(sdk.NewCoins(bond.Add(bond), sdkBond))
+			ctx, _ := suite.ctx.CacheContext()
+
+			msg := tc.msg(sender)
+			_, err := suite.app.MsgServiceRouter().Handler(msg)(ctx, msg)
+			if tc.expectErr != nil {
+				suite.Require().ErrorIs(err, tc.expectErr)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+
+	// the staking flows still move the sdkbond tokens of the intermediary account
+	_, err := suite.msgServer.Undelegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgUndelegate(delAddr, suite.validator.GetOperator(), sdk.NewInt64Coin(bondDenom, 1000)))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestSDKBondTransferable() {
+	_, _, recipient := testdata.KeyTestPubAddr()
+	sdkBond := sdk.NewInt64Coin(suite.app.StakingKeeper.BondDenom(suite.ctx), 1000)
+	send := func(sender sdk.AccAddress) error {
+		msg := banktypes.NewMsgSend(sender, recipient, sdk.NewCoins(sdkBond))
+		_, err := suite.app.MsgServiceRouter().Handler(msg)(suite.ctx, msg)
+		return err
+	}
+
+	// the sdkbond denom being a bond denom does not make it transferable
+	suite.msKeeper.SetBondTokenWeight(suite.ctx, sdkBond.Denom, sdk.OneDec())
+	suite.Require().False(suite.msKeeper.SDKBondTransferable(suite.ctx))
+	suite.Require().ErrorIs(send(suite.fundedAccount(sdk.NewCoins(sdkBond))), types.ErrSDKBondNotTransferable)
+
+	// the sdkbond tokens held by users, such as their staking rewards, are
+	// transferable once the param is set
+	params := suite.msKeeper.GetParams(suite.ctx)
+	params.SdkBondTransferable = true
+	suite.msKeeper.SetParams(suite.ctx, params)
+	suite.Require().NoError(send(suite.fundedAccount(sdk.NewCoins(sdkBond))))
+	suite.Require().Equal(sdkBond, suite.app.BankKeeper.GetBalance(suite.ctx, recipient, sdkBond.Denom))
+
+	// but never those of the intermediary accounts
+	delAddr := suite.delegateAll(suite.validator.GetOperator(), 1000)[0]
+	intermediaryAccount := types.IntermediaryAccount(delAddr, suite.validator.GetOperator())
+	suite.Require().True(types.IsIntermediaryAccount(intermediaryAccount))
+	suite.Require().ErrorIs(send(intermediaryAccount), types.ErrSDKBondNotTransferable)
+	suite.Require().NoError(suite.msKeeper.ValidateSender(suite.ctx, delAddr, sdk.NewCoins(sdkBond)))
+}
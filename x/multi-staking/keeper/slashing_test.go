@@ -0,0 +1,252 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/notional-labs/multi-staking-module/testing/simapp"
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+func (suite *KeeperTestSuite) TestUnbondingSlashedByEvidence() {
+	testCases := []struct {
+		name          string
+		withRecipient bool
+	}{
+		{
+			name: "remainder is burned",
+		},
+		{
+			name:          "remainder is sent to the recipient",
+			withRecipient: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.disableInflation()
+			suite.nextBlock(suite.ctx.BlockTime())
+			valAddr := suite.validator.GetOperator()
+
+			var recipient sdk.AccAddress
+			if tc.withRecipient {
+				_, _, recipient = testdata.KeyTestPubAddr()
+				suite.msKeeper.SetParams(suite.ctx, types.NewParams(recipient.String(), types.DefaultReweightingBatchSize, types.DefaultWeightEpochLength, types.DefaultSunsetBatchSize, types.DefaultSDKBondTransferable))
+			}
+
+			delegated := sdk.NewInt64Coin(bondDenom, 1000)
+			delAddr := suite.fundedAccount(sdk.NewCoins(delegated))
+			intermediaryAccount := types.IntermediaryAccount(delAddr, valAddr)
+			bondSupply := suite.app.BankKeeper.GetSupply(suite.ctx, bondDenom)
+
+			_, err := suite.msgServer.Delegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgDelegate(delAddr, valAddr, delegated))
+			suite.Require().NoError(err)
+			res, err := suite.msgServer.Undelegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgUndelegate(delAddr, valAddr, delegated))
+			suite.Require().NoError(err)
+			infractionHeight := suite.ctx.BlockHeight()
+
+			// the validator double signs at the height of the undelegation, so
+			// the 500stake unbonding entry is slashed by 5%
+			suite.nextBlock(suite.ctx.BlockTime())
+			consAddr, err := suite.validator.GetConsAddr()
+			suite.Require().NoError(err)
+			suite.app.SlashingKeeper.SetValidatorSigningInfo(
+				suite.ctx, consAddr, slashingtypes.NewValidatorSigningInfo(consAddr, 0, 0, time.Unix(0, 0), false, 0),
+			)
+			suite.app.EvidenceKeeper.HandleEquivocationEvidence(suite.ctx, &evidencetypes.Equivocation{
+				Height:           infractionHeight,
+				Time:             suite.ctx.BlockTime(),
+				Power:            suite.validator.GetConsensusPower(suite.app.StakingKeeper.PowerReduction(suite.ctx)),
+				ConsensusAddress: consAddr.String(),
+			})
+			ubd, _ := suite.app.StakingKeeper.GetUnbondingDelegation(suite.ctx, intermediaryAccount, valAddr)
+			suite.Require().Equal(sdk.NewInt(475), ubd.Entries[0].Balance)
+
+			suite.nextBlock(res.CompletionTime)
+
+			// the delegator gets the bond tokens backing the remaining 475stake
+			expectedUnlocked := sdk.NewInt64Coin(bondDenom, 950)
+			remainder := delegated.Sub(expectedUnlocked)
+			suite.Require().Equal(expectedUnlocked, suite.app.BankKeeper.GetBalance(suite.ctx, delAddr, bondDenom))
+			suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, intermediaryAccount).IsZero())
+			if tc.withRecipient {
+				suite.Require().Equal(remainder, suite.app.BankKeeper.GetBalance(suite.ctx, recipient, bondDenom))
+				suite.Require().Equal(bondSupply, suite.app.BankKeeper.GetSupply(suite.ctx, bondDenom))
+			} else {
+				suite.Require().Equal(bondSupply.Sub(remainder), suite.app.BankKeeper.GetSupply(suite.ctx, bondDenom))
+			}
+
+			_, found := suite.msKeeper.GetDVPairUnbondingTokens(suite.ctx, delAddr, valAddr, res.CompletionTime)
+			suite.Require().False(found)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUndelegateAfterSlashing() {
+	suite.disableInflation()
+	suite.nextBlock(suite.ctx.BlockTime())
+	valAddr := suite.validator.GetOperator()
+	sdkBondDenom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	sdkBondSupply := suite.app.BankKeeper.GetSupply(suite.ctx, sdkBondDenom)
+
+	delegations := []sdk.Coin{
+		sdk.NewInt64Coin(bondDenom, 1000),
+		sdk.NewInt64Coin(bondDenom, 601),
+	}
+	delegators := make([]sdk.AccAddress, len(delegations))
+	for i, delegated := range delegations {
+		delegators[i] = suite.fundedAccount(sdk.NewCoins(delegated))
+		_, err := suite.msgServer.Delegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgDelegate(delegators[i], valAddr, delegated))
+		suite.Require().NoError(err)
+	}
+	bondSupply := suite.app.BankKeeper.GetSupply(suite.ctx, bondDenom)
+
+	// slash the validator by 10% for an infraction in the current block
+	consAddr, err := suite.validator.GetConsAddr()
+	suite.Require().NoError(err)
+	validator, _ := suite.app.StakingKeeper.GetValidator(suite.ctx, valAddr)
+	power := validator.GetConsensusPower(suite.app.StakingKeeper.PowerReduction(suite.ctx))
+	suite.app.SlashingKeeper.Slash(suite.ctx, consAddr, sdk.NewDecWithPrec(1, 1), power, suite.ctx.BlockHeight())
+	validator, _ = suite.app.StakingKeeper.GetValidator(suite.ctx, valAddr)
+
+	// each delegator undelegates half of its bond tokens and then the rest
+	expectedUnlocked := make([]sdk.Int, len(delegations))
+	totalUnlocked := sdk.ZeroInt()
+	for i, delegated := range delegations {
+		delAddr := delegators[i]
+		intermediaryAccount := types.IntermediaryAccount(delAddr, valAddr)
+		delegation, _ := suite.app.StakingKeeper.GetDelegation(suite.ctx, intermediaryAccount, valAddr)
+		delegationTokens := validator.TokensFromShares(delegation.Shares)
+		sdkBondTokens, _ := suite.msKeeper.GetDVPairSDKBondTokens(suite.ctx, delAddr, valAddr)
+		suite.Require().True(delegationTokens.LT(sdk.NewDecFromInt(sdkBondTokens.Amount)))
+
+		half := sdk.NewCoin(bondDenom, delegated.Amount.QuoRaw(2))
+		_, err := suite.msgServer.Undelegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgUndelegate(delAddr, valAddr, half))
+		suite.Require().NoError(err)
+		_, err = suite.msgServer.Undelegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgUndelegate(delAddr, valAddr, delegated.Sub(half)))
+		suite.Require().NoError(err)
+
+		// the delegator gets its bond tokens pro-rata to the sdkbond tokens
+		// returned by the slashed sdk delegation
+		ubd, _ := suite.app.StakingKeeper.GetUnbondingDelegation(suite.ctx, intermediaryAccount, valAddr)
+		returned := sdk.ZeroInt()
+		for _, entry := range ubd.Entries {
+			returned = returned.Add(entry.Balance)
+		}
+		suite.Require().True(sdk.NewDecFromInt(returned).LTE(delegationTokens))
+		expectedUnlocked[i] = delegated.Amount.Mul(returned).Quo(sdkBondTokens.Amount)
+		totalUnlocked = totalUnlocked.Add(expectedUnlocked[i])
+	}
+
+	unbondingTime := suite.app.StakingKeeper.UnbondingTime(suite.ctx)
+	suite.nextBlock(suite.ctx.BlockTime().Add(unbondingTime))
+
+	for i, delAddr := range delegators {
+		suite.Require().Equal(expectedUnlocked[i], suite.app.BankKeeper.GetBalance(suite.ctx, delAddr, bondDenom).Amount)
+		suite.Require().True(expectedUnlocked[i].LT(delegations[i].Amount))
+		suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, types.IntermediaryAccount(delAddr, valAddr)).IsZero())
+	}
+
+	// the remainder of the bond tokens is burned along with all the minted
+	// sdkbond tokens which were not slashed
+	totalDelegated := delegations[0].Add(delegations[1])
+	suite.Require().Equal(bondSupply.SubAmount(totalDelegated.Amount.Sub(totalUnlocked)), suite.app.BankKeeper.GetSupply(suite.ctx, bondDenom))
+	suite.Require().True(suite.app.BankKeeper.GetSupply(suite.ctx, sdkBondDenom).IsLT(sdkBondSupply))
+}
+
+func (suite *KeeperTestSuite) TestUnjail() {
+	valAddr := suite.createValidator(sdk.NewInt64Coin(bondDenom, 1000))
+	validator, _ := suite.app.StakingKeeper.GetValidator(suite.ctx, valAddr)
+	consAddr, err := validator.GetConsAddr()
+	suite.Require().NoError(err)
+
+	// the validator is jailed for downtime until an hour later
+	jailedUntil := suite.ctx.BlockTime().Add(time.Hour)
+	suite.app.SlashingKeeper.SetValidatorSigningInfo(
+		suite.ctx, consAddr, slashingtypes.NewValidatorSigningInfo(consAddr, 0, 0, jailedUntil, false, 0),
+	)
+	suite.app.StakingKeeper.Jail(suite.ctx, consAddr)
+
+	// the operator has no sdk self-delegation, its intermediary account has
+	_, err = slashingkeeper.NewMsgServerImpl(suite.app.SlashingKeeper).Unjail(
+		sdk.WrapSDKContext(suite.ctx), slashingtypes.NewMsgUnjail(valAddr),
+	)
+	suite.Require().ErrorIs(err, slashingtypes.ErrMissingSelfDelegation)
+
+	_, err = suite.msgServer.Unjail(sdk.WrapSDKContext(suite.ctx), types.NewMsgUnjail(valAddr))
+	suite.Require().ErrorIs(err, slashingtypes.ErrValidatorJailed)
+
+	suite.ctx = suite.ctx.WithBlockTime(jailedUntil)
+	_, err = suite.msgServer.Unjail(sdk.WrapSDKContext(suite.ctx), types.NewMsgUnjail(valAddr))
+	suite.Require().NoError(err)
+	validator, _ = suite.app.StakingKeeper.GetValidator(suite.ctx, valAddr)
+	suite.Require().False(validator.IsJailed())
+
+	_, err = suite.msgServer.Unjail(sdk.WrapSDKContext(suite.ctx), types.NewMsgUnjail(valAddr))
+	suite.Require().ErrorIs(err, slashingtypes.ErrValidatorNotJailed)
+}
+
+func (suite *KeeperTestSuite) TestUnjailSunsettingValidator() {
+	suite.msKeeper.SetBondTokenWeight(suite.ctx, sunsetDenom, sdk.OneDec())
+	valAddr := suite.createValidator(sdk.NewInt64Coin(sunsetDenom, 1000))
+	suite.nextBlock(suite.ctx.BlockTime())
+
+	// the sunset jails the validator without a jail period, it stays jailed
+	// until its delegations are force-undelegated
+	suite.removeBondToken(sunsetDenom)
+	_, err := suite.msgServer.Unjail(sdk.WrapSDKContext(suite.ctx), types.NewMsgUnjail(valAddr))
+	suite.Require().ErrorIs(err, types.ErrBondDenomSunsetting)
+	validator, _ := suite.app.StakingKeeper.GetValidator(suite.ctx, valAddr)
+	suite.Require().True(validator.IsJailed())
+}
+
+func (suite *KeeperTestSuite) TestMinSelfDelegation() {
+	suite.disableInflation()
+	selfDelegation := sdk.NewInt64Coin(bondDenom, 1000)
+	valAddr := sdk.ValAddress(suite.fundedAccount(sdk.NewCoins(selfDelegation)))
+	delAddr := sdk.AccAddress(valAddr)
+
+	// 1000ulp of weight 0.5 are worth 500stake
+	msg, err := types.NewMsgCreateValidator(
+		valAddr, ed25519.GenPrivKey().PubKey(), selfDelegation,
+		stakingtypes.NewDescription("moniker", "", "", "", ""),
+		stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		sdk.NewInt(400), bondDenom,
+	)
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.CreateValidator(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+
+	// unbonding the self-delegation below the min self delegation jails the validator
+	_, err = suite.msgServer.Undelegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgUndelegate(delAddr, valAddr, sdk.NewInt64Coin(bondDenom, 100)))
+	suite.Require().NoError(err)
+	validator, _ := suite.app.StakingKeeper.GetValidator(suite.ctx, valAddr)
+	suite.Require().False(validator.IsJailed())
+
+	_, err = suite.msgServer.Undelegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgUndelegate(delAddr, valAddr, sdk.NewInt64Coin(bondDenom, 200)))
+	suite.Require().NoError(err)
+	validator, _ = suite.app.StakingKeeper.GetValidator(suite.ctx, valAddr)
+	suite.Require().True(validator.IsJailed())
+
+	// the delegations of other delegators do not count
+	suite.delegateAll(valAddr, 1000)
+	_, err = suite.msgServer.Unjail(sdk.WrapSDKContext(suite.ctx), types.NewMsgUnjail(valAddr))
+	suite.Require().ErrorIs(err, slashingtypes.ErrSelfDelegationTooLowToUnjail)
+
+	// the never bonded validator is unjailed once its self-delegation is back
+	delegated := sdk.NewInt64Coin(bondDenom, 200)
+	suite.Require().NoError(simapp.FundAccount(suite.app, suite.ctx, delAddr, sdk.NewCoins(delegated)))
+	_, err = suite.msgServer.Delegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgDelegate(delAddr, valAddr, delegated))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Unjail(sdk.WrapSDKContext(suite.ctx), types.NewMsgUnjail(valAddr))
+	suite.Require().NoError(err)
+	suite.requireInvariant()
+}
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// validateStakingCaps checks that delegating bond tokens, which add an amount
+// of sdkbond tokens to the tokens of their validator, keeps their denom within
+// its staking caps. The sdkbond tokens are counted as voting power, as the
+// delegation may bond its validator.
+//
+// Only new stake is checked: reweighting a bond denom to a higher weight may
+// raise its power share above its max power share, after which its headroom is
+// zero until its share drops below the cap again.
+func (k Keeper) validateStakingCaps(ctx sdk.Context, bondTokens sdk.Coin, sdkBondAmount math.Int) error {
+	caps, found := k.GetStakingCaps(ctx, bondTokens.Denom)
+	if !found || !caps.IsCapped() {
+		return nil
+	}
+
+	if caps.MaxBondedTokens.IsPositive() {
+		bonded := k.GetBondedTokens(ctx, bondTokens.Denom).Add(bondTokens.Amount)
+		if bonded.GT(caps.MaxBondedTokens) {
+			return sdkerrors.Wrapf(
+				types.ErrStakingCapExceeded, "%s %s would be bonded, above the max bonded tokens %s",
+				bonded, bondTokens.Denom, caps.MaxBondedTokens,
+			)
+		}
+	}
+
+	if caps.MaxPowerShare.IsPositive() {
+		denomPower, totalPower := k.bondDenomPower(ctx, bondTokens.Denom)
+		share := sdk.NewDecFromInt(denomPower.Add(sdkBondAmount)).QuoInt(totalPower.Add(sdkBondAmount))
+		if share.GT(caps.MaxPowerShare) {
+			return sdkerrors.Wrapf(
+				types.ErrStakingCapExceeded, "validators of %s would hold %s of the validator power, above the max power share %s",
+				bondTokens.Denom, share, caps.MaxPowerShare,
+			)
+		}
+	}
+
+	return nil
+}
+
+// bondDenomPower returns the tokens of the bonded validators pinned to a bond
+// denom and the tokens of all bonded validators. The unbonded validators hold
+// no voting power, whatever their tokens.
+func (k Keeper) bondDenomPower(ctx sdk.Context, denom string) (math.Int, math.Int) {
+	denomPower, totalPower := math.ZeroInt(), math.ZeroInt()
+	k.stakingKeeper.IterateBondedValidatorsByPower(ctx, func(_ int64, validator stakingtypes.ValidatorI) bool {
+		totalPower = totalPower.Add(validator.GetTokens())
+		if bondDenom, found := k.GetValidatorBondDenom(ctx, validator.GetOperator()); found && bondDenom == denom {
+			denomPower = denomPower.Add(validator.GetTokens())
+		}
+		return false
+	})
+	return denomPower, totalPower
+}
+
+// GetStakingHeadroom returns the staking caps of a bond denom, its current
+// staking and the bond tokens that can still be delegated within the caps. The
+// headroom of the max power share is converted to bond tokens at the weight of
+// the bond denom, so it is subject to the rounding of the sdkbond tokens.
+func (k Keeper) GetStakingHeadroom(ctx sdk.Context, denom string) types.StakingHeadroom {
+	caps, found := k.GetStakingCaps(ctx, denom)
+	if !found {
+		caps = types.NewStakingCaps(math.ZeroInt(), sdk.ZeroDec())
+	}
+
+	headroom := types.StakingHeadroom{
+		BondDenom:    denom,
+		Caps:         caps,
+		BondedTokens: k.GetBondedTokens(ctx, denom),
+		PowerShare:   sdk.ZeroDec(),
+		Capped:       caps.IsCapped(),
+		Headroom:     math.ZeroInt(),
+	}
+
+	denomPower, totalPower := k.bondDenomPower(ctx, denom)
+	if totalPower.IsPositive() {
+		headroom.PowerShare = sdk.NewDecFromInt(denomPower).QuoInt(totalPower)
+	}
+	if !headroom.Capped {
+		return headroom
+	}
+
+	var limits []math.Int
+	if caps.MaxBondedTokens.IsPositive() {
+		limits = append(limits, caps.MaxBondedTokens.Sub(headroom.BondedTokens))
+	}
+	if caps.MaxPowerShare.IsPositive() {
+		// the power p that can be added solves (denomPower + p) / (totalPower + p) = max power share
+		power := caps.MaxPowerShare.MulInt(totalPower).Sub(sdk.NewDecFromInt(denomPower)).
+			Quo(sdk.OneDec().Sub(caps.MaxPowerShare))
+		weight, _ := k.GetBondTokenWeight(ctx, denom)
+		if weight.IsPositive() {
+			limits = append(limits, power.Quo(weight).TruncateInt())
+		} else {
+			limits = append(limits, math.ZeroInt())
+		}
+	}
+
+	headroom.Headroom = limits[0]
+	for _, limit := range limits[1:] {
+		headroom.Headroom = math.MinInt(headroom.Headroom, limit)
+	}
+	if headroom.Headroom.IsNegative() {
+		headroom.Headroom = math.ZeroInt()
+	}
+	return headroom
+}
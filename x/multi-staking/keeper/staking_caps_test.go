@@ -0,0 +1,190 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/notional-labs/multi-staking-module/testing/simapp"
+	multistaking "github.com/notional-labs/multi-staking-module/x/multi-staking"
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// setStakingCaps executes a passed set staking caps proposal
+func (suite *KeeperTestSuite) setStakingCaps(denom string, maxBondedTokens int64, maxPowerShare string) {
+	handler := multistaking.NewProposalHandler(suite.msKeeper)
+	caps := types.NewStakingCaps(sdk.NewInt(maxBondedTokens), sdk.MustNewDecFromStr(maxPowerShare))
+	suite.Require().NoError(handler(suite.ctx, types.NewSetStakingCapsProposal("title", "description", denom, caps)))
+}
+
+func (suite *KeeperTestSuite) queryStakingHeadroom(denom string) types.StakingHeadroom {
+	res, err := suite.queryClient.StakingHeadroom(sdk.WrapSDKContext(suite.ctx), &types.QueryStakingHeadroomRequest{BondDenom: denom})
+	suite.Require().NoError(err)
+	return res.Headroom
+}
+
+func (suite *KeeperTestSuite) TestMaxBondedTokens() {
+	valAddr := suite.validator.GetOperator()
+	suite.setStakingCaps(bondDenom, 1000, "0")
+
+	delegate := func(amount int64) error {
+		delegated := sdk.NewInt64Coin(bondDenom, amount)
+		delAddr := suite.fundedAccount(sdk.NewCoins(delegated))
+		_, err := suite.msgServer.Delegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgDelegate(delAddr, valAddr, delegated))
+		return err
+	}
+
+	suite.Require().NoError(delegate(600))
+	suite.Require().ErrorIs(delegate(401), types.ErrStakingCapExceeded)
+
+	headroom := suite.queryStakingHeadroom(bondDenom)
+	suite.Require().True(headroom.Capped)
+	suite.Require().Equal(sdk.NewInt(600), headroom.BondedTokens)
+	suite.Require().Equal(sdk.NewInt(400), headroom.Headroom)
+
+	suite.Require().NoError(delegate(400))
+	suite.Require().True(suite.queryStakingHeadroom(bondDenom).Headroom.IsZero())
+	suite.requireInvariant()
+
+	// removing the caps lifts the limit
+	suite.setStakingCaps(bondDenom, 0, "0")
+	suite.Require().NoError(delegate(2))
+	suite.Require().False(suite.queryStakingHeadroom(bondDenom).Capped)
+}
+
+func (suite *KeeperTestSuite) TestMaxPowerShare() {
+	suite.msKeeper.SetBondTokenWeight(suite.ctx, convertDenom, sdk.OneDec())
+	// the validator of uatom holds half of the tokens of all validators
+	genesisTokens := suite.validator.GetTokens().Int64()
+	valAddr := suite.createValidator(sdk.NewInt64Coin(convertDenom, genesisTokens))
+	suite.setStakingCaps(convertDenom, 0, "0.6")
+
+	// the validator holds no voting power until it is bonded
+	suite.Require().True(suite.queryStakingHeadroom(convertDenom).PowerShare.IsZero())
+	suite.nextBlock(suite.ctx.BlockTime())
+
+	// (genesisTokens + headroom) / (2 * genesisTokens + headroom) = 0.6
+	headroom := suite.queryStakingHeadroom(convertDenom)
+	suite.Require().True(headroom.Capped)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.5"), headroom.PowerShare)
+	suite.Require().Equal(sdk.NewInt(genesisTokens/2), headroom.Headroom)
+
+	delegate := func(amount int64) error {
+		delegated := sdk.NewInt64Coin(convertDenom, amount)
+		delAddr := suite.fundedAccount(sdk.NewCoins(delegated))
+		_, err := suite.msgServer.Delegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgDelegate(delAddr, valAddr, delegated))
+		return err
+	}
+	suite.Require().ErrorIs(delegate(genesisTokens/2+1), types.ErrStakingCapExceeded)
+	suite.Require().NoError(delegate(genesisTokens / 2))
+	suite.Require().True(suite.queryStakingHeadroom(convertDenom).Headroom.IsZero())
+
+	// a new validator of the denom is capped too
+	selfDelegation := sdk.NewInt64Coin(convertDenom, 1000)
+	msg, err := types.NewMsgCreateValidator(
+		sdk.ValAddress(suite.fundedAccount(sdk.NewCoins(selfDelegation))), ed25519.GenPrivKey().PubKey(), selfDelegation,
+		stakingtypes.NewDescription("moniker", "", "", "", ""),
+		stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		sdk.OneInt(), convertDenom,
+	)
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.CreateValidator(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrStakingCapExceeded)
+}
+
+func (suite *KeeperTestSuite) TestReweightingAboveMaxPowerShare() {
+	suite.msKeeper.SetBondTokenWeight(suite.ctx, convertDenom, sdk.OneDec())
+	genesisTokens := suite.validator.GetTokens().Int64()
+	valAddr := suite.createValidator(sdk.NewInt64Coin(convertDenom, genesisTokens))
+	suite.nextBlock(suite.ctx.BlockTime())
+	suite.setStakingCaps(convertDenom, 0, "0.6")
+
+	// the reweighting is not checked against the staking caps, doubling the
+	// sdkbond tokens of the validator brings its power share to 2/3
+	suite.changeBondTokenWeight(convertDenom, sdk.NewDec(2))
+	suite.nextBlock(suite.ctx.BlockTime())
+	validator, _ := suite.app.StakingKeeper.GetValidator(suite.ctx, valAddr)
+	suite.Require().Equal(sdk.NewInt(2*genesisTokens), validator.GetTokens())
+	headroom := suite.queryStakingHeadroom(convertDenom)
+	suite.Require().True(headroom.PowerShare.GT(sdk.MustNewDecFromStr("0.6")))
+	suite.Require().True(headroom.Headroom.IsZero())
+	suite.requireInvariant()
+
+	// but no new stake is accepted until the power share drops below the cap
+	delegated := sdk.NewInt64Coin(convertDenom, 1)
+	delAddr := suite.fundedAccount(sdk.NewCoins(delegated))
+	_, err := suite.msgServer.Delegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgDelegate(delAddr, valAddr, delegated))
+	suite.Require().ErrorIs(err, types.ErrStakingCapExceeded)
+}
+
+func (suite *KeeperTestSuite) TestStakingCapsOfRestakedTokens() {
+	suite.disableInflation()
+	valAddr := suite.validator.GetOperator()
+	delAddr := suite.delegateAll(valAddr, 1000)[0]
+
+	// cancelling an unbonding delegation stakes the bond tokens again
+	res, err := suite.msgServer.Undelegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgUndelegate(delAddr, valAddr, sdk.NewInt64Coin(bondDenom, 600)))
+	suite.Require().NoError(err)
+	creationHeight := suite.ctx.BlockHeight()
+	suite.nextBlock(suite.ctx.BlockTime())
+	suite.setStakingCaps(bondDenom, 800, "0")
+
+	_, err = suite.msgServer.CancelUnbondingDelegation(
+		sdk.WrapSDKContext(suite.ctx), types.NewMsgCancelUnbondingDelegation(delAddr, valAddr, creationHeight, sdk.NewInt64Coin(bondDenom, 401)),
+	)
+	suite.Require().ErrorIs(err, types.ErrStakingCapExceeded)
+	_, err = suite.msgServer.CancelUnbondingDelegation(
+		sdk.WrapSDKContext(suite.ctx), types.NewMsgCancelUnbondingDelegation(delAddr, valAddr, creationHeight, sdk.NewInt64Coin(bondDenom, 400)),
+	)
+	suite.Require().NoError(err)
+	suite.nextBlock(res.CompletionTime)
+
+	// converting redelegated bond tokens stakes them in the destination denom
+	suite.msKeeper.SetBondTokenWeight(suite.ctx, convertDenom, sdk.OneDec())
+	valDstAddr := suite.createValidator(sdk.NewInt64Coin(convertDenom, 1000))
+	reserve := types.ConversionReserve()
+	suite.Require().NoError(simapp.FundAccount(suite.app, suite.ctx, reserve, sdk.NewCoins(sdk.NewInt64Coin(convertDenom, 1000))))
+	suite.setStakingCaps(convertDenom, 1100, "0")
+
+	// 400ulp of weight 0.5 are worth 200uatom of weight 1
+	_, err = suite.msgServer.BeginRedelegate(
+		sdk.WrapSDKContext(suite.ctx), types.NewMsgBeginRedelegate(delAddr, valAddr, valDstAddr, sdk.NewInt64Coin(bondDenom, 400), true),
+	)
+	suite.Require().ErrorIs(err, types.ErrStakingCapExceeded)
+	_, err = suite.msgServer.BeginRedelegate(
+		sdk.WrapSDKContext(suite.ctx), types.NewMsgBeginRedelegate(delAddr, valAddr, valDstAddr, sdk.NewInt64Coin(bondDenom, 200), true),
+	)
+	suite.Require().NoError(err)
+	suite.requireInvariant()
+}
+
+func (suite *KeeperTestSuite) TestSetStakingCapsProposal() {
+	handler := multistaking.NewProposalHandler(suite.msKeeper)
+	caps := types.NewStakingCaps(sdk.NewInt(1000), sdk.MustNewDecFromStr("0.3"))
+
+	err := handler(suite.ctx, types.NewSetStakingCapsProposal("title", "description", convertDenom, caps))
+	suite.Require().ErrorIs(err, types.ErrInvalidBondDenom)
+
+	suite.Require().NoError(handler(suite.ctx, types.NewSetStakingCapsProposal("title", "description", bondDenom, caps)))
+	stored, found := suite.msKeeper.GetStakingCaps(suite.ctx, bondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(caps, stored)
+
+	res, err := suite.queryClient.StakingHeadrooms(sdk.WrapSDKContext(suite.ctx), &types.QueryStakingHeadroomsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Headrooms, 1)
+	suite.Require().Equal(caps, res.Headrooms[0].Caps)
+
+	// caps without any limit remove the staking caps
+	uncapped := types.NewStakingCaps(sdk.ZeroInt(), sdk.ZeroDec())
+	suite.Require().NoError(handler(suite.ctx, types.NewSetStakingCapsProposal("title", "description", bondDenom, uncapped)))
+	_, found = suite.msKeeper.GetStakingCaps(suite.ctx, bondDenom)
+	suite.Require().False(found)
+
+	// sunsetting the bond denom removes its staking caps
+	suite.Require().NoError(handler(suite.ctx, types.NewSetStakingCapsProposal("title", "description", bondDenom, caps)))
+	suite.removeBondToken(bondDenom)
+	suite.nextBlock(suite.ctx.BlockTime())
+	_, found = suite.msKeeper.GetStakingCaps(suite.ctx, bondDenom)
+	suite.Require().False(found)
+}
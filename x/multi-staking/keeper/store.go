@@ -0,0 +1,646 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// GetBondTokenWeight returns the weight of a bond denom
+func (k Keeper) GetBondTokenWeight(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBondTokenWeightKey(denom))
+	if bz == nil {
+		return sdk.Dec{}, false
+	}
+
+	var weight sdk.DecProto
+	k.cdc.MustUnmarshal(bz, &weight)
+	return weight.Dec, true
+}
+
+// SetBondTokenWeight sets the weight of a bond denom
+func (k Keeper) SetBondTokenWeight(ctx sdk.Context, denom string, weight sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.DecProto{Dec: weight})
+	store.Set(types.GetBondTokenWeightKey(denom), bz)
+}
+
+// DeleteBondTokenWeight removes a bond denom and its weight
+func (k Keeper) DeleteBondTokenWeight(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetBondTokenWeightKey(denom))
+}
+
+// IterateBondTokenWeights iterates over all bond denoms and their weights
+func (k Keeper) IterateBondTokenWeights(ctx sdk.Context, cb func(denom string, weight sdk.Dec) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BondTokenWeightKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var weight sdk.DecProto
+		k.cdc.MustUnmarshal(iterator.Value(), &weight)
+		if cb(string(iterator.Key()), weight.Dec) {
+			break
+		}
+	}
+}
+
+// GetBondDenomSunsetHeight returns the height at which the removal of a bond denom started
+func (k Keeper) GetBondDenomSunsetHeight(ctx sdk.Context, denom string) (int64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBondDenomSunsetHeightKey(denom))
+	if bz == nil {
+		return 0, false
+	}
+	return int64(sdk.BigEndianToUint64(bz)), true
+}
+
+// SetBondDenomSunsetHeight sets the height at which the removal of a bond denom started
+func (k Keeper) SetBondDenomSunsetHeight(ctx sdk.Context, denom string, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBondDenomSunsetHeightKey(denom), sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// DeleteBondDenomSunsetHeight removes the sunset height of a bond denom
+func (k Keeper) DeleteBondDenomSunsetHeight(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetBondDenomSunsetHeightKey(denom))
+}
+
+// IterateBondDenomSunsetHeights iterates over all sunsetting bond denoms and their sunset heights
+func (k Keeper) IterateBondDenomSunsetHeights(ctx sdk.Context, cb func(denom string, height int64) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BondDenomSunsetHeightKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(string(iterator.Key()), int64(sdk.BigEndianToUint64(iterator.Value()))) {
+			break
+		}
+	}
+}
+
+// GetReweighting returns the reweighting job of a bond denom
+func (k Keeper) GetReweighting(ctx sdk.Context, denom string) (types.Reweighting, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetReweightingKey(denom))
+	if bz == nil {
+		return types.Reweighting{}, false
+	}
+
+	var reweighting types.Reweighting
+	k.cdc.MustUnmarshal(bz, &reweighting)
+	return reweighting, true
+}
+
+// SetReweighting sets the reweighting job of a bond denom
+func (k Keeper) SetReweighting(ctx sdk.Context, denom string, reweighting types.Reweighting) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetReweightingKey(denom), k.cdc.MustMarshal(&reweighting))
+}
+
+// DeleteReweighting removes the reweighting job of a bond denom
+func (k Keeper) DeleteReweighting(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetReweightingKey(denom))
+}
+
+// IterateReweightings iterates over the reweighting jobs of all bond denoms
+func (k Keeper) IterateReweightings(ctx sdk.Context, cb func(denom string, reweighting types.Reweighting) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ReweightingKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var reweighting types.Reweighting
+		k.cdc.MustUnmarshal(iterator.Value(), &reweighting)
+		if cb(string(iterator.Key()), reweighting) {
+			break
+		}
+	}
+}
+
+// GetStakingCaps returns the staking caps of a bond denom
+func (k Keeper) GetStakingCaps(ctx sdk.Context, denom string) (types.StakingCaps, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetStakingCapsKey(denom))
+	if bz == nil {
+		return types.StakingCaps{}, false
+	}
+
+	var caps types.StakingCaps
+	k.cdc.MustUnmarshal(bz, &caps)
+	return caps, true
+}
+
+// SetStakingCaps sets the staking caps of a bond denom
+func (k Keeper) SetStakingCaps(ctx sdk.Context, denom string, caps types.StakingCaps) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetStakingCapsKey(denom), k.cdc.MustMarshal(&caps))
+}
+
+// DeleteStakingCaps removes the staking caps of a bond denom
+func (k Keeper) DeleteStakingCaps(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetStakingCapsKey(denom))
+}
+
+// IterateStakingCaps iterates over the staking caps of all capped bond denoms
+func (k Keeper) IterateStakingCaps(ctx sdk.Context, cb func(denom string, caps types.StakingCaps) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StakingCapsKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var caps types.StakingCaps
+		k.cdc.MustUnmarshal(iterator.Value(), &caps)
+		if cb(string(iterator.Key()), caps) {
+			break
+		}
+	}
+}
+
+// GetWeightBounds returns the weight bounds of a bond denom
+func (k Keeper) GetWeightBounds(ctx sdk.Context, denom string) (types.WeightBounds, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetWeightBoundsKey(denom))
+	if bz == nil {
+		return types.WeightBounds{}, false
+	}
+
+	var bounds types.WeightBounds
+	k.cdc.MustUnmarshal(bz, &bounds)
+	return bounds, true
+}
+
+// SetWeightBounds sets the weight bounds of a bond denom
+func (k Keeper) SetWeightBounds(ctx sdk.Context, denom string, bounds types.WeightBounds) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetWeightBoundsKey(denom), k.cdc.MustMarshal(&bounds))
+}
+
+// DeleteWeightBounds removes the weight bounds of a bond denom
+func (k Keeper) DeleteWeightBounds(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetWeightBoundsKey(denom))
+}
+
+// IterateWeightBounds iterates over the weight bounds of all bond denoms with a
+// dynamic weight
+func (k Keeper) IterateWeightBounds(ctx sdk.Context, cb func(denom string, bounds types.WeightBounds) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WeightBoundsKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var bounds types.WeightBounds
+		k.cdc.MustUnmarshal(iterator.Value(), &bounds)
+		if cb(string(iterator.Key()), bounds) {
+			break
+		}
+	}
+}
+
+// GetBondedTokens returns the bond tokens of a bond denom locked for all DV
+// pairs. It is kept by SetDVPairBondTokens and DeleteDVPairBondTokens.
+func (k Keeper) GetBondedTokens(ctx sdk.Context, denom string) math.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBondedTokensKey(denom))
+	if bz == nil {
+		return math.ZeroInt()
+	}
+
+	var amount sdk.IntProto
+	k.cdc.MustUnmarshal(bz, &amount)
+	return amount.Int
+}
+
+// IterateBondedTokens iterates over the bonded tokens of all bond denoms with
+// bond tokens locked
+func (k Keeper) IterateBondedTokens(ctx sdk.Context, cb func(denom string, amount math.Int) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BondedTokensKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.IntProto
+		k.cdc.MustUnmarshal(iterator.Value(), &amount)
+		if cb(string(iterator.Key()), amount.Int) {
+			break
+		}
+	}
+}
+
+// addBondedTokens adds an amount, which may be negative, to the bonded tokens
+// of a bond denom
+func (k Keeper) addBondedTokens(ctx sdk.Context, denom string, amount math.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bonded := k.GetBondedTokens(ctx, denom).Add(amount)
+	if bonded.IsZero() {
+		store.Delete(types.GetBondedTokensKey(denom))
+		return
+	}
+	store.Set(types.GetBondedTokensKey(denom), k.cdc.MustMarshal(&sdk.IntProto{Int: bonded}))
+}
+
+// GetIssuedSDKBondTokens returns the sdkbond tokens issued by the module for
+// the DV pairs and not retired yet. They are issued when minted for a DV pair,
+// and retired with the unbonding tokens record they end up in once it
+// completes, whatever slashing left of them.
+func (k Keeper) GetIssuedSDKBondTokens(ctx sdk.Context) math.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.IssuedSDKBondTokensKey)
+	if bz == nil {
+		return math.ZeroInt()
+	}
+
+	var amount sdk.IntProto
+	k.cdc.MustUnmarshal(bz, &amount)
+	return amount.Int
+}
+
+// SetIssuedSDKBondTokens sets the sdkbond tokens issued by the module for the
+// DV pairs and not retired yet
+func (k Keeper) SetIssuedSDKBondTokens(ctx sdk.Context, amount math.Int) {
+	store := ctx.KVStore(k.storeKey)
+	if amount.IsZero() {
+		store.Delete(types.IssuedSDKBondTokensKey)
+		return
+	}
+	store.Set(types.IssuedSDKBondTokensKey, k.cdc.MustMarshal(&sdk.IntProto{Int: amount}))
+}
+
+// addIssuedSDKBondTokens adds an amount, which may be negative, to the sdkbond
+// tokens issued by the module
+func (k Keeper) addIssuedSDKBondTokens(ctx sdk.Context, amount math.Int) {
+	k.SetIssuedSDKBondTokens(ctx, k.GetIssuedSDKBondTokens(ctx).Add(amount))
+}
+
+// GetBondDenomUses returns the number of DV pair bond tokens and unbonding
+// tokens records of a bond denom, which must be zero for the denom to be
+// removed. It is kept by the setters and deleters of those records.
+func (k Keeper) GetBondDenomUses(ctx sdk.Context, denom string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBondDenomUsesKey(denom))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// IterateBondDenomUses iterates over the number of records of all bond denoms
+// in use
+func (k Keeper) IterateBondDenomUses(ctx sdk.Context, cb func(denom string, uses uint64) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BondDenomUsesKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(string(iterator.Key()), sdk.BigEndianToUint64(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// incBondDenomUses increments the number of records of a bond denom
+func (k Keeper) incBondDenomUses(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBondDenomUsesKey(denom), sdk.Uint64ToBigEndian(k.GetBondDenomUses(ctx, denom)+1))
+}
+
+// decBondDenomUses decrements the number of records of a bond denom
+func (k Keeper) decBondDenomUses(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	uses := k.GetBondDenomUses(ctx, denom)
+	if uses <= 1 {
+		store.Delete(types.GetBondDenomUsesKey(denom))
+		return
+	}
+	store.Set(types.GetBondDenomUsesKey(denom), sdk.Uint64ToBigEndian(uses-1))
+}
+
+// GetSunsetCursor returns the DV pair key the next force-undelegation batch
+// of a sunsetting bond denom starts from
+func (k Keeper) GetSunsetCursor(ctx sdk.Context, denom string) []byte {
+	store := ctx.KVStore(k.storeKey)
+	return store.Get(types.GetSunsetCursorKey(denom))
+}
+
+// SetSunsetCursor sets the DV pair key the next force-undelegation batch of a
+// sunsetting bond denom starts from
+func (k Keeper) SetSunsetCursor(ctx sdk.Context, denom string, next []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetSunsetCursorKey(denom), next)
+}
+
+// DeleteSunsetCursor removes the force-undelegation cursor of a bond denom, so
+// that the next batch starts a new pass over the DV pairs
+func (k Keeper) DeleteSunsetCursor(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetSunsetCursorKey(denom))
+}
+
+// IterateSunsetCursors iterates over the force-undelegation cursors of the
+// sunsetting bond denoms in the middle of a pass over the DV pairs
+func (k Keeper) IterateSunsetCursors(ctx sdk.Context, cb func(denom string, next []byte) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SunsetCursorKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(string(iterator.Key()), iterator.Value()) {
+			break
+		}
+	}
+}
+
+// GetValidatorBondDenom returns the bond denom of a validator
+func (k Keeper) GetValidatorBondDenom(ctx sdk.Context, valAddr sdk.ValAddress) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorBondDenomKey(valAddr))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// SetValidatorBondDenom sets the bond denom of a validator
+func (k Keeper) SetValidatorBondDenom(ctx sdk.Context, valAddr sdk.ValAddress, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorBondDenomKey(valAddr), []byte(denom))
+}
+
+// DeleteValidatorBondDenom removes the bond denom of a validator
+func (k Keeper) DeleteValidatorBondDenom(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorBondDenomKey(valAddr))
+}
+
+// IterateValidatorBondDenoms iterates over all validators and their bond denoms
+func (k Keeper) IterateValidatorBondDenoms(ctx sdk.Context, cb func(valAddr sdk.ValAddress, denom string) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorBondDenomKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// the key is the length prefixed validator address
+		valAddr := sdk.ValAddress(iterator.Key()[1:])
+		if cb(valAddr, string(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// GetIntermediaryAccountDelegator returns the delegator an intermediary account acts for
+func (k Keeper) GetIntermediaryAccountDelegator(ctx sdk.Context, intermediaryAccount sdk.AccAddress) (sdk.AccAddress, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetIntermediaryAccountDelegatorKey(intermediaryAccount))
+	if bz == nil {
+		return nil, false
+	}
+	return sdk.AccAddress(bz), true
+}
+
+// SetIntermediaryAccountDelegator sets the delegator an intermediary account acts for
+func (k Keeper) SetIntermediaryAccountDelegator(ctx sdk.Context, intermediaryAccount sdk.AccAddress, delAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetIntermediaryAccountDelegatorKey(intermediaryAccount), delAddr)
+}
+
+// DeleteIntermediaryAccountDelegator removes the delegator of an intermediary account
+func (k Keeper) DeleteIntermediaryAccountDelegator(ctx sdk.Context, intermediaryAccount sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetIntermediaryAccountDelegatorKey(intermediaryAccount))
+}
+
+// IterateIntermediaryAccountDelegators iterates over all intermediary accounts and their delegators
+func (k Keeper) IterateIntermediaryAccountDelegators(ctx sdk.Context, cb func(intermediaryAccount, delAddr sdk.AccAddress) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.IntermediaryAccountDelegatorKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// the key is the length prefixed intermediary account address
+		intermediaryAccount := sdk.AccAddress(iterator.Key()[1:])
+		if cb(intermediaryAccount, sdk.AccAddress(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// GetDVPairSDKBondTokens returns the sdkbond tokens minted for a DV pair
+func (k Keeper) GetDVPairSDKBondTokens(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coin, bool) {
+	return k.getCoin(ctx, types.GetDVPairSDKBondTokenKey(delAddr, valAddr))
+}
+
+// SetDVPairSDKBondTokens sets the sdkbond tokens minted for a DV pair
+func (k Keeper) SetDVPairSDKBondTokens(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, sdkBondTokens sdk.Coin) {
+	k.setCoin(ctx, types.GetDVPairSDKBondTokenKey(delAddr, valAddr), sdkBondTokens)
+}
+
+// DeleteDVPairSDKBondTokens removes the sdkbond tokens record of a DV pair
+func (k Keeper) DeleteDVPairSDKBondTokens(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDVPairSDKBondTokenKey(delAddr, valAddr))
+}
+
+// IterateDVPairSDKBondTokens iterates over the sdkbond tokens of all DV pairs
+func (k Keeper) IterateDVPairSDKBondTokens(ctx sdk.Context, cb func(delAddr sdk.AccAddress, valAddr sdk.ValAddress, sdkBondTokens sdk.Coin) (stop bool)) {
+	k.iterateDVPairCoins(ctx, types.DVPairSDKBondTokenKey, nil, cb)
+}
+
+// GetDVPairBondTokens returns the bond tokens locked for a DV pair
+func (k Keeper) GetDVPairBondTokens(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coin, bool) {
+	return k.getCoin(ctx, types.GetDVPairBondTokenKey(delAddr, valAddr))
+}
+
+// SetDVPairBondTokens sets the bond tokens locked for a DV pair and updates
+// the bonded tokens of their denom
+func (k Keeper) SetDVPairBondTokens(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, bondTokens sdk.Coin) {
+	if current, found := k.GetDVPairBondTokens(ctx, delAddr, valAddr); found {
+		k.addBondedTokens(ctx, current.Denom, current.Amount.Neg())
+		k.decBondDenomUses(ctx, current.Denom)
+	}
+	k.addBondedTokens(ctx, bondTokens.Denom, bondTokens.Amount)
+	k.incBondDenomUses(ctx, bondTokens.Denom)
+	k.setCoin(ctx, types.GetDVPairBondTokenKey(delAddr, valAddr), bondTokens)
+}
+
+// DeleteDVPairBondTokens removes the bond tokens record of a DV pair and
+// updates the bonded tokens of their denom
+func (k Keeper) DeleteDVPairBondTokens(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	if current, found := k.GetDVPairBondTokens(ctx, delAddr, valAddr); found {
+		k.addBondedTokens(ctx, current.Denom, current.Amount.Neg())
+		k.decBondDenomUses(ctx, current.Denom)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDVPairBondTokenKey(delAddr, valAddr))
+}
+
+// IterateDVPairBondTokens iterates over the bond tokens of all DV pairs
+func (k Keeper) IterateDVPairBondTokens(ctx sdk.Context, cb func(delAddr sdk.AccAddress, valAddr sdk.ValAddress, bondTokens sdk.Coin) (stop bool)) {
+	k.iterateDVPairCoins(ctx, types.DVPairBondTokenKey, nil, cb)
+}
+
+// IterateDelegatorDVPairBondTokens iterates over the bond tokens of the DV pairs of a delegator
+func (k Keeper) IterateDelegatorDVPairBondTokens(
+	ctx sdk.Context, delAddr sdk.AccAddress, cb func(valAddr sdk.ValAddress, bondTokens sdk.Coin) (stop bool),
+) {
+	k.iterateDVPairCoins(ctx, types.DVPairBondTokenKey, types.GetDelegatorDVPairsPrefix(delAddr),
+		func(_ sdk.AccAddress, valAddr sdk.ValAddress, bondTokens sdk.Coin) bool {
+			return cb(valAddr, bondTokens)
+		},
+	)
+}
+
+// GetDVPairUnbondingTokens returns the tokens of a DV pair unbonding until the given completion time
+func (k Keeper) GetDVPairUnbondingTokens(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, completionTime time.Time,
+) (types.UnbondingTokens, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetDVPairUnbondingTokensKey(delAddr, valAddr, completionTime))
+	if bz == nil {
+		return types.UnbondingTokens{}, false
+	}
+
+	var tokens types.UnbondingTokens
+	k.cdc.MustUnmarshal(bz, &tokens)
+	return tokens, true
+}
+
+// SetDVPairUnbondingTokens sets the tokens of a DV pair unbonding until the
+// given completion time and updates the uses of their bond denom
+func (k Keeper) SetDVPairUnbondingTokens(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, completionTime time.Time, tokens types.UnbondingTokens,
+) {
+	if current, found := k.GetDVPairUnbondingTokens(ctx, delAddr, valAddr, completionTime); found {
+		k.decBondDenomUses(ctx, current.BondTokens.Denom)
+	}
+	k.incBondDenomUses(ctx, tokens.BondTokens.Denom)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDVPairUnbondingTokensKey(delAddr, valAddr, completionTime), k.cdc.MustMarshal(&tokens))
+}
+
+// DeleteDVPairUnbondingTokens removes the record of the tokens of a DV pair
+// unbonding until the given completion time and updates the uses of their
+// bond denom
+func (k Keeper) DeleteDVPairUnbondingTokens(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, completionTime time.Time) {
+	if current, found := k.GetDVPairUnbondingTokens(ctx, delAddr, valAddr, completionTime); found {
+		k.decBondDenomUses(ctx, current.BondTokens.Denom)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDVPairUnbondingTokensKey(delAddr, valAddr, completionTime))
+}
+
+// IterateDVPairUnbondingTokens iterates over the unbonding tokens of all DV pairs
+func (k Keeper) IterateDVPairUnbondingTokens(
+	ctx sdk.Context,
+	cb func(delAddr sdk.AccAddress, valAddr sdk.ValAddress, completionTime time.Time, tokens types.UnbondingTokens) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DVPairUnbondingTokensKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		delAddr, valAddr, completionTime := types.ParseDVPairUnbondingTokensKey(iterator.Key())
+		var tokens types.UnbondingTokens
+		k.cdc.MustUnmarshal(iterator.Value(), &tokens)
+		if cb(delAddr, valAddr, completionTime, tokens) {
+			break
+		}
+	}
+}
+
+// GetCompletedDelegations returns the completed delegations collected in the current block
+func (k Keeper) GetCompletedDelegations(ctx sdk.Context) []types.CompletedDelegation {
+	store := ctx.KVStore(k.memKey)
+	bz := store.Get(types.CompletedDelegationsKey)
+	if bz == nil {
+		return nil
+	}
+
+	var completed types.CompletedDelegations
+	k.cdc.MustUnmarshal(bz, &completed)
+	return completed.Delegations
+}
+
+// SetCompletedDelegations sets the completed delegations of the current block
+func (k Keeper) SetCompletedDelegations(ctx sdk.Context, delegations []types.CompletedDelegation) {
+	store := ctx.KVStore(k.memKey)
+	bz := k.cdc.MustMarshal(&types.CompletedDelegations{Delegations: delegations})
+	store.Set(types.CompletedDelegationsKey, bz)
+}
+
+// DeleteCompletedDelegations removes the completed delegations of the current block
+func (k Keeper) DeleteCompletedDelegations(ctx sdk.Context) {
+	store := ctx.KVStore(k.memKey)
+	store.Delete(types.CompletedDelegationsKey)
+}
+
+// GetCompletedRedelegations returns the completed redelegations collected in the current block
+func (k Keeper) GetCompletedRedelegations(ctx sdk.Context) []types.CompletedRedelegation {
+	store := ctx.KVStore(k.memKey)
+	bz := store.Get(types.CompletedRedelegationsKey)
+	if bz == nil {
+		return nil
+	}
+
+	var completed types.CompletedRedelegations
+	k.cdc.MustUnmarshal(bz, &completed)
+	return completed.Redelegations
+}
+
+// SetCompletedRedelegations sets the completed redelegations of the current block
+func (k Keeper) SetCompletedRedelegations(ctx sdk.Context, redelegations []types.CompletedRedelegation) {
+	store := ctx.KVStore(k.memKey)
+	bz := k.cdc.MustMarshal(&types.CompletedRedelegations{Redelegations: redelegations})
+	store.Set(types.CompletedRedelegationsKey, bz)
+}
+
+// DeleteCompletedRedelegations removes the completed redelegations of the current block
+func (k Keeper) DeleteCompletedRedelegations(ctx sdk.Context) {
+	store := ctx.KVStore(k.memKey)
+	store.Delete(types.CompletedRedelegationsKey)
+}
+
+func (k Keeper) getCoin(ctx sdk.Context, key []byte) (sdk.Coin, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(key)
+	if bz == nil {
+		return sdk.Coin{}, false
+	}
+
+	var coin sdk.Coin
+	k.cdc.MustUnmarshal(bz, &coin)
+	return coin, true
+}
+
+func (k Keeper) setCoin(ctx sdk.Context, key []byte, coin sdk.Coin) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, k.cdc.MustMarshal(&coin))
+}
+
+// iterateDVPairCoins iterates over the coins stored under prefixKey for the DV
+// pairs whose key starts with dvPairPrefix
+func (k Keeper) iterateDVPairCoins(
+	ctx sdk.Context, prefixKey, dvPairPrefix []byte, cb func(delAddr sdk.AccAddress, valAddr sdk.ValAddress, coin sdk.Coin) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), prefixKey)
+	iterator := sdk.KVStorePrefixIterator(store, dvPairPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		delAddr, valAddr := types.ParseDVPairKey(iterator.Key())
+		var coin sdk.Coin
+		k.cdc.MustUnmarshal(iterator.Value(), &coin)
+		if cb(delAddr, valAddr, coin) {
+			break
+		}
+	}
+}
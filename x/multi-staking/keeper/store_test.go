@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+func (suite *KeeperTestSuite) TestBondTokenWeight() {
+	_, found := suite.msKeeper.GetBondTokenWeight(suite.ctx, "uatom")
+	suite.Require().False(found)
+
+	suite.msKeeper.SetBondTokenWeight(suite.ctx, "uatom", sdk.MustNewDecFromStr("0.25"))
+
+	weight, found := suite.msKeeper.GetBondTokenWeight(suite.ctx, "uatom")
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.25"), weight)
+
+	weights := map[string]sdk.Dec{}
+	suite.msKeeper.IterateBondTokenWeights(suite.ctx, func(denom string, weight sdk.Dec) bool {
+		weights[denom] = weight
+		return false
+	})
+	suite.Require().Equal(map[string]sdk.Dec{bondDenom: bondWeight, "uatom": sdk.MustNewDecFromStr("0.25")}, weights)
+
+	suite.msKeeper.DeleteBondTokenWeight(suite.ctx, "uatom")
+	_, found = suite.msKeeper.GetBondTokenWeight(suite.ctx, "uatom")
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestValidatorBondDenom() {
+	_, _, addr := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(addr)
+
+	_, found := suite.msKeeper.GetValidatorBondDenom(suite.ctx, valAddr)
+	suite.Require().False(found)
+
+	suite.msKeeper.SetValidatorBondDenom(suite.ctx, valAddr, "ulp")
+	denom, found := suite.msKeeper.GetValidatorBondDenom(suite.ctx, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal("ulp", denom)
+
+	denoms := map[string]string{}
+	suite.msKeeper.IterateValidatorBondDenoms(suite.ctx, func(v sdk.ValAddress, denom string) bool {
+		denoms[v.String()] = denom
+		return false
+	})
+	suite.Require().Equal("ulp", denoms[valAddr.String()])
+
+	suite.msKeeper.DeleteValidatorBondDenom(suite.ctx, valAddr)
+	_, found = suite.msKeeper.GetValidatorBondDenom(suite.ctx, valAddr)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestIntermediaryAccountDelegator() {
+	_, _, intermediaryAccount := testdata.KeyTestPubAddr()
+	_, _, delAddr := testdata.KeyTestPubAddr()
+
+	suite.msKeeper.SetIntermediaryAccountDelegator(suite.ctx, intermediaryAccount, delAddr)
+	got, found := suite.msKeeper.GetIntermediaryAccountDelegator(suite.ctx, intermediaryAccount)
+	suite.Require().True(found)
+	suite.Require().Equal(delAddr, got)
+
+	count := 0
+	suite.msKeeper.IterateIntermediaryAccountDelegators(suite.ctx, func(i, d sdk.AccAddress) bool {
+		suite.Require().Equal(intermediaryAccount, i)
+		suite.Require().Equal(delAddr, d)
+		count++
+		return false
+	})
+	suite.Require().Equal(1, count)
+
+	suite.msKeeper.DeleteIntermediaryAccountDelegator(suite.ctx, intermediaryAccount)
+	_, found = suite.msKeeper.GetIntermediaryAccountDelegator(suite.ctx, intermediaryAccount)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestDVPairTokens() {
+	_, _, delAddr := testdata.KeyTestPubAddr()
+	_, _, addr := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(addr)
+
+	sdkBondTokens := sdk.NewInt64Coin(sdk.DefaultBondDenom, 500)
+	bondTokens := sdk.NewInt64Coin("ulp", 1000)
+	suite.msKeeper.SetDVPairSDKBondTokens(suite.ctx, delAddr, valAddr, sdkBondTokens)
+	suite.msKeeper.SetDVPairBondTokens(suite.ctx, delAddr, valAddr, bondTokens)
+
+	got, found := suite.msKeeper.GetDVPairSDKBondTokens(suite.ctx, delAddr, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(sdkBondTokens, got)
+
+	got, found = suite.msKeeper.GetDVPairBondTokens(suite.ctx, delAddr, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(bondTokens, got)
+
+	suite.msKeeper.IterateDVPairBondTokens(suite.ctx, func(d sdk.AccAddress, v sdk.ValAddress, coin sdk.Coin) bool {
+		suite.Require().Equal(delAddr, d)
+		suite.Require().Equal(valAddr, v)
+		suite.Require().Equal(bondTokens, coin)
+		return false
+	})
+	suite.msKeeper.IterateDVPairSDKBondTokens(suite.ctx, func(d sdk.AccAddress, v sdk.ValAddress, coin sdk.Coin) bool {
+		suite.Require().Equal(delAddr, d)
+		suite.Require().Equal(valAddr, v)
+		suite.Require().Equal(sdkBondTokens, coin)
+		return false
+	})
+
+	suite.msKeeper.DeleteDVPairSDKBondTokens(suite.ctx, delAddr, valAddr)
+	suite.msKeeper.DeleteDVPairBondTokens(suite.ctx, delAddr, valAddr)
+	_, found = suite.msKeeper.GetDVPairSDKBondTokens(suite.ctx, delAddr, valAddr)
+	suite.Require().False(found)
+	_, found = suite.msKeeper.GetDVPairBondTokens(suite.ctx, delAddr, valAddr)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestDVPairUnbondingTokens() {
+	_, _, delAddr := testdata.KeyTestPubAddr()
+	_, _, addr := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(addr)
+	completionTime := time.Unix(1000, 0).UTC()
+
+	tokens := types.UnbondingTokens{
+		BondTokens:    sdk.NewInt64Coin("ulp", 1000),
+		SDKBondTokens: sdk.NewInt64Coin(sdk.DefaultBondDenom, 500),
+	}
+	suite.msKeeper.SetDVPairUnbondingTokens(suite.ctx, delAddr, valAddr, completionTime, tokens)
+
+	got, found := suite.msKeeper.GetDVPairUnbondingTokens(suite.ctx, delAddr, valAddr, completionTime)
+	suite.Require().True(found)
+	suite.Require().Equal(tokens, got)
+	_, found = suite.msKeeper.GetDVPairUnbondingTokens(suite.ctx, delAddr, valAddr, completionTime.Add(time.Second))
+	suite.Require().False(found)
+
+	suite.msKeeper.IterateDVPairUnbondingTokens(suite.ctx, func(d sdk.AccAddress, v sdk.ValAddress, t time.Time, u types.UnbondingTokens) bool {
+		suite.Require().Equal(delAddr, d)
+		suite.Require().Equal(valAddr, v)
+		suite.Require().Equal(completionTime, t)
+		suite.Require().Equal(tokens, u)
+		return false
+	})
+
+	suite.msKeeper.DeleteDVPairUnbondingTokens(suite.ctx, delAddr, valAddr, completionTime)
+	_, found = suite.msKeeper.GetDVPairUnbondingTokens(suite.ctx, delAddr, valAddr, completionTime)
+	suite.Require().False(found)
+}
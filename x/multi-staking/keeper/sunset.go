@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// SunsetBondDenom starts the removal of a bond denom. New delegations of the
+// denom are refused and the validators pinned to it are jailed right away,
+// while the existing delegations are force-undelegated in EndBlock. The denom
+// is removed once all its bond tokens are returned to the delegators.
+func (k Keeper) SunsetBondDenom(ctx sdk.Context, denom string) error {
+	if !k.IsBondDenom(ctx, denom) {
+		return sdkerrors.Wrapf(types.ErrInvalidBondDenom, "%s", denom)
+	}
+	if k.IsBondDenomSunsetting(ctx, denom) {
+		return sdkerrors.Wrapf(types.ErrBondDenomSunsetting, "%s", denom)
+	}
+
+	k.SetBondDenomSunsetHeight(ctx, denom, ctx.BlockHeight())
+
+	for _, valAddr := range k.validatorsOfBondDenom(ctx, denom) {
+		if err := k.jailValidator(ctx, valAddr); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSunsetBondDenom,
+			sdk.NewAttribute(types.AttributeKeyBondDenom, denom),
+		),
+	)
+
+	return nil
+}
+
+// ProcessSunsettingBondDenoms force-undelegates the delegations of the
+// sunsetting bond denoms and removes the denoms which no longer have any
+// delegated or unbonding bond tokens. The DV pairs are scanned in batches,
+// with at most SunsetBatchSize DV pairs scanned per block over all the denoms.
+func (k Keeper) ProcessSunsettingBondDenoms(ctx sdk.Context) {
+	var denoms []string
+	k.IterateBondDenomSunsetHeights(ctx, func(denom string, _ int64) bool {
+		denoms = append(denoms, denom)
+		return false
+	})
+
+	budget := int(k.SunsetBatchSize(ctx))
+	for _, denom := range denoms {
+		// a denom with only unbonding tokens left has no DV pair to scan
+		if budget > 0 && k.GetBondedTokens(ctx, denom).IsPositive() {
+			budget -= k.forceUndelegateBatch(ctx, denom, budget)
+		}
+
+		if k.GetBondDenomUses(ctx, denom) == 0 {
+			k.removeBondDenom(ctx, denom)
+		}
+	}
+}
+
+// forceUndelegateBatch undelegates the bond tokens of a denom of the DV pairs
+// of the next batch of at most limit DV pairs, and returns the number of DV
+// pairs scanned. A DV pair which cannot be undelegated, e.g. because it has
+// reached the maximum number of unbonding entries, is retried in the next pass
+// over the DV pairs.
+func (k Keeper) forceUndelegateBatch(ctx sdk.Context, denom string, limit int) int {
+	keys, pairs := k.dvPairBondTokensFrom(ctx, k.GetSunsetCursor(ctx, denom), nil, limit+1)
+	batch := len(pairs)
+	if batch > limit {
+		batch = limit
+	}
+
+	for _, pair := range pairs[:batch] {
+		if pair.bondTokens.Denom != denom {
+			continue
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		completionTime, err := k.Undelegate(cacheCtx, pair.delAddr, pair.valAddr, pair.bondTokens)
+		if err != nil {
+			k.Logger(ctx).Info(
+				"failed to force-undelegate sunsetting bond denom", "delegator", pair.delAddr,
+				"validator", pair.valAddr, "amount", pair.bondTokens, "err", err,
+			)
+			continue
+		}
+		write()
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeForceUnbond,
+				sdk.NewAttribute(types.AttributeKeyValidator, pair.valAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyDelegator, pair.delAddr.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, pair.bondTokens.String()),
+				sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+			),
+		)
+	}
+
+	if len(pairs) > batch {
+		k.SetSunsetCursor(ctx, denom, keys[batch])
+	} else {
+		k.DeleteSunsetCursor(ctx, denom)
+	}
+	return batch
+}
+
+// removeBondDenom removes a sunsetting bond denom, its weight and the bond
+// denom of the validators pinned to it
+func (k Keeper) removeBondDenom(ctx sdk.Context, denom string) {
+	sunsetHeight, _ := k.GetBondDenomSunsetHeight(ctx, denom)
+
+	for _, valAddr := range k.validatorsOfBondDenom(ctx, denom) {
+		k.DeleteValidatorBondDenom(ctx, valAddr)
+	}
+	k.DeleteBondTokenWeight(ctx, denom)
+	k.DeleteBondDenomSunsetHeight(ctx, denom)
+	k.DeleteSunsetCursor(ctx, denom)
+	k.DeleteReweighting(ctx, denom)
+	k.DeleteStakingCaps(ctx, denom)
+	k.DeleteWeightBounds(ctx, denom)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveBondDenom,
+			sdk.NewAttribute(types.AttributeKeyBondDenom, denom),
+			sdk.NewAttribute(types.AttributeKeySunsetHeight, strconv.FormatInt(sunsetHeight, 10)),
+		),
+	)
+}
+
+// validatorsOfBondDenom returns the validators pinned to a bond denom
+func (k Keeper) validatorsOfBondDenom(ctx sdk.Context, denom string) []sdk.ValAddress {
+	var validators []sdk.ValAddress
+	k.IterateValidatorBondDenoms(ctx, func(valAddr sdk.ValAddress, bondDenom string) bool {
+		if bondDenom == denom {
+			validators = append(validators, valAddr)
+		}
+		return false
+	})
+	return validators
+}
+
+// jailValidator jails a validator unless it is already jailed or no longer exists
+func (k Keeper) jailValidator(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found || validator.IsJailed() {
+		return nil
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+	k.stakingKeeper.Jail(ctx, consAddr)
+	return nil
+}
@@ -0,0 +1,212 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	multistaking "github.com/notional-labs/multi-staking-module/x/multi-staking"
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+const sunsetDenom = "uatom"
+
+// createValidator creates a validator pinned to the given bond denom with a
+// self-delegation of the given amount and returns its operator address
+func (suite *KeeperTestSuite) createValidator(selfDelegation sdk.Coin) sdk.ValAddress {
+	valAddr := sdk.ValAddress(suite.fundedAccount(sdk.NewCoins(selfDelegation)))
+	msg, err := types.NewMsgCreateValidator(
+		valAddr, ed25519.GenPrivKey().PubKey(), selfDelegation,
+		stakingtypes.NewDescription("moniker", "", "", "", ""),
+		stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		sdk.OneInt(), selfDelegation.Denom,
+	)
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.CreateValidator(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+	return valAddr
+}
+
+// removeBondToken executes a passed remove bond token proposal
+func (suite *KeeperTestSuite) removeBondToken(denom string) {
+	handler := multistaking.NewProposalHandler(suite.msKeeper)
+	suite.Require().NoError(handler(suite.ctx, types.NewRemoveBondTokenProposal("title", "description", denom)))
+}
+
+func containsEventType(events []abci.Event, eventType string) bool {
+	for _, event := range events {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func (suite *KeeperTestSuite) TestSunsetBondDenom() {
+	suite.disableInflation()
+	suite.msKeeper.SetBondTokenWeight(suite.ctx, sunsetDenom, sdk.OneDec())
+	selfDelegation := sdk.NewInt64Coin(sunsetDenom, 1000)
+	valAddr := suite.createValidator(selfDelegation)
+	suite.nextBlock(suite.ctx.BlockTime())
+
+	delegations := map[string]sdk.Coin{
+		sdk.AccAddress(valAddr).String(): selfDelegation,
+	}
+	var delAddr sdk.AccAddress
+	for _, amount := range []int64{300, 700} {
+		delegated := sdk.NewInt64Coin(sunsetDenom, amount)
+		delAddr = suite.fundedAccount(sdk.NewCoins(delegated))
+		_, err := suite.msgServer.Delegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgDelegate(delAddr, valAddr, delegated))
+		suite.Require().NoError(err)
+		delegations[delAddr.String()] = delegated
+	}
+
+	// the last delegator is already unbonding part of its delegation
+	_, err := suite.msgServer.Undelegate(
+		sdk.WrapSDKContext(suite.ctx), types.NewMsgUndelegate(delAddr, valAddr, sdk.NewInt64Coin(sunsetDenom, 200)),
+	)
+	suite.Require().NoError(err)
+	suite.nextBlock(suite.ctx.BlockTime())
+
+	// the proposal starts the sunset of the denom and jails its validators
+	suite.removeBondToken(sunsetDenom)
+	suite.Require().True(suite.msKeeper.IsBondDenom(suite.ctx, sunsetDenom))
+	suite.Require().True(suite.msKeeper.IsBondDenomSunsetting(suite.ctx, sunsetDenom))
+	validator, _ := suite.app.StakingKeeper.GetValidator(suite.ctx, valAddr)
+	suite.Require().True(validator.IsJailed())
+
+	// new delegations and validators of the denom are refused
+	newDelegated := sdk.NewInt64Coin(sunsetDenom, 100)
+	newDelAddr := suite.fundedAccount(sdk.NewCoins(newDelegated))
+	_, err = suite.msgServer.Delegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgDelegate(newDelAddr, valAddr, newDelegated))
+	suite.Require().ErrorIs(err, types.ErrBondDenomSunsetting)
+	msg, err := types.NewMsgCreateValidator(
+		sdk.ValAddress(newDelAddr), ed25519.GenPrivKey().PubKey(), newDelegated,
+		stakingtypes.NewDescription("moniker", "", "", "", ""),
+		stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		sdk.OneInt(), sunsetDenom,
+	)
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.CreateValidator(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrBondDenomSunsetting)
+
+	// the existing delegations are force-undelegated at the end of the block
+	events := suite.nextBlock(suite.ctx.BlockTime())
+	suite.Require().True(containsEventType(events, types.EventTypeForceUnbond))
+	for delegator := range delegations {
+		delAddr := sdk.MustAccAddressFromBech32(delegator)
+		_, found := suite.msKeeper.GetDVPairBondTokens(suite.ctx, delAddr, valAddr)
+		suite.Require().False(found)
+		_, found = suite.app.StakingKeeper.GetDelegation(suite.ctx, types.IntermediaryAccount(delAddr, valAddr), valAddr)
+		suite.Require().False(found)
+	}
+	suite.Require().True(suite.msKeeper.IsBondDenomSunsetting(suite.ctx, sunsetDenom))
+
+	// the denom is removed once all its bond tokens are returned
+	unbondingTime := suite.app.StakingKeeper.UnbondingTime(suite.ctx)
+	events = suite.nextBlock(suite.ctx.BlockTime().Add(unbondingTime))
+	suite.Require().True(containsEventType(events, types.EventTypeRemoveBondDenom))
+	for delegator, delegated := range delegations {
+		delAddr := sdk.MustAccAddressFromBech32(delegator)
+		suite.Require().Equal(delegated, suite.app.BankKeeper.GetBalance(suite.ctx, delAddr, sunsetDenom))
+		suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, types.IntermediaryAccount(delAddr, valAddr)).IsZero())
+	}
+	suite.Require().False(suite.msKeeper.IsBondDenom(suite.ctx, sunsetDenom))
+	suite.Require().False(suite.msKeeper.IsBondDenomSunsetting(suite.ctx, sunsetDenom))
+	_, found := suite.msKeeper.GetValidatorBondDenom(suite.ctx, valAddr)
+	suite.Require().False(found)
+
+	// the other bond denoms are left untouched
+	suite.Require().True(suite.msKeeper.IsBondDenom(suite.ctx, bondDenom))
+	validator, _ = suite.app.StakingKeeper.GetValidator(suite.ctx, suite.validator.GetOperator())
+	suite.Require().False(validator.IsJailed())
+}
+
+func (suite *KeeperTestSuite) TestSunsetBondDenomRetriesForcedUndelegation() {
+	suite.disableInflation()
+	suite.msKeeper.SetBondTokenWeight(suite.ctx, sunsetDenom, sdk.OneDec())
+	selfDelegation := sdk.NewInt64Coin(sunsetDenom, 1000)
+	valAddr := suite.createValidator(selfDelegation)
+	delAddr := sdk.AccAddress(valAddr)
+	suite.nextBlock(suite.ctx.BlockTime())
+
+	// with a single unbonding entry allowed, the forced undelegation has to
+	// wait for the ongoing unbonding to complete
+	params := suite.app.StakingKeeper.GetParams(suite.ctx)
+	params.MaxEntries = 1
+	suite.app.StakingKeeper.SetParams(suite.ctx, params)
+	res, err := suite.msgServer.Undelegate(
+		sdk.WrapSDKContext(suite.ctx), types.NewMsgUndelegate(delAddr, valAddr, sdk.NewInt64Coin(sunsetDenom, 400)),
+	)
+	suite.Require().NoError(err)
+
+	suite.removeBondToken(sunsetDenom)
+	events := suite.nextBlock(suite.ctx.BlockTime())
+	suite.Require().False(containsEventType(events, types.EventTypeForceUnbond))
+	bondTokens, found := suite.msKeeper.GetDVPairBondTokens(suite.ctx, delAddr, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt64Coin(sunsetDenom, 600), bondTokens)
+
+	events = suite.nextBlock(res.CompletionTime)
+	suite.Require().True(containsEventType(events, types.EventTypeForceUnbond))
+	suite.Require().False(containsEventType(events, types.EventTypeRemoveBondDenom))
+	suite.Require().Equal(sdk.NewInt64Coin(sunsetDenom, 400), suite.app.BankKeeper.GetBalance(suite.ctx, delAddr, sunsetDenom))
+	_, found = suite.msKeeper.GetDVPairBondTokens(suite.ctx, delAddr, valAddr)
+	suite.Require().False(found)
+
+	unbondingTime := suite.app.StakingKeeper.UnbondingTime(suite.ctx)
+	events = suite.nextBlock(suite.ctx.BlockTime().Add(unbondingTime))
+	suite.Require().True(containsEventType(events, types.EventTypeRemoveBondDenom))
+	suite.Require().Equal(selfDelegation, suite.app.BankKeeper.GetBalance(suite.ctx, delAddr, sunsetDenom))
+	suite.Require().False(suite.msKeeper.IsBondDenom(suite.ctx, sunsetDenom))
+}
+
+func (suite *KeeperTestSuite) TestSunsetBondDenomInBatches() {
+	suite.disableInflation()
+	suite.msKeeper.SetBondTokenWeight(suite.ctx, sunsetDenom, sdk.OneDec())
+	valAddr := suite.createValidator(sdk.NewInt64Coin(sunsetDenom, 1000))
+	var delegators []sdk.AccAddress
+	for i := 0; i < 4; i++ {
+		delegated := sdk.NewInt64Coin(sunsetDenom, 100)
+		delAddr := suite.fundedAccount(sdk.NewCoins(delegated))
+		_, err := suite.msgServer.Delegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgDelegate(delAddr, valAddr, delegated))
+		suite.Require().NoError(err)
+		delegators = append(delegators, delAddr)
+	}
+	// the DV pairs of the other bond denom are scanned too
+	suite.delegateAll(suite.validator.GetOperator(), 100, 100)
+	suite.Require().Equal(uint64(5), suite.msKeeper.GetBondDenomUses(suite.ctx, sunsetDenom))
+
+	params := suite.msKeeper.GetParams(suite.ctx)
+	params.SunsetBatchSize = 2
+	suite.msKeeper.SetParams(suite.ctx, params)
+	suite.removeBondToken(sunsetDenom)
+
+	// the DV pairs are scanned 2 per block
+	dvPairs := 0
+	suite.msKeeper.IterateDVPairBondTokens(suite.ctx, func(_ sdk.AccAddress, _ sdk.ValAddress, _ sdk.Coin) bool {
+		dvPairs++
+		return false
+	})
+	for i := 0; i < (dvPairs+1)/2; i++ {
+		suite.Require().Equal(i == 0, suite.msKeeper.GetSunsetCursor(suite.ctx, sunsetDenom) == nil)
+		events := suite.nextBlock(suite.ctx.BlockTime())
+		suite.Require().LessOrEqual(countEventType(events, types.EventTypeForceUnbond), 2)
+	}
+	suite.Require().True(suite.msKeeper.GetBondedTokens(suite.ctx, sunsetDenom).IsZero())
+	for _, delAddr := range delegators {
+		_, found := suite.msKeeper.GetDVPairBondTokens(suite.ctx, delAddr, valAddr)
+		suite.Require().False(found)
+	}
+
+	// the unbonding tokens keep the denom in use until they are returned
+	suite.Require().Equal(uint64(5), suite.msKeeper.GetBondDenomUses(suite.ctx, sunsetDenom))
+	suite.requireInvariant()
+	unbondingTime := suite.app.StakingKeeper.UnbondingTime(suite.ctx)
+	suite.nextBlock(suite.ctx.BlockTime().Add(unbondingTime))
+	suite.Require().Zero(suite.msKeeper.GetBondDenomUses(suite.ctx, sunsetDenom))
+	suite.Require().False(suite.msKeeper.IsBondDenom(suite.ctx, sunsetDenom))
+	suite.Require().Nil(suite.msKeeper.GetSunsetCursor(suite.ctx, sunsetDenom))
+	suite.requireInvariant()
+}
@@ -0,0 +1,319 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// Undelegate starts the sdk unbonding of the sdkbond tokens backing the given
+// amount of bond tokens. The bond tokens are moved from the DV pair records to
+// the unbonding tokens of the completion time, but stay locked in the
+// intermediary account until the unbonding completes.
+func (k Keeper) Undelegate(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) (time.Time, error) {
+	if err := k.validateValidatorBondDenom(ctx, valAddr, amount.Denom); err != nil {
+		return time.Time{}, err
+	}
+
+	shares, sdkBondAmount, err := k.unbondShares(ctx, delAddr, valAddr, amount)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if _, err := k.withdrawRewards(ctx, delAddr, valAddr); err != nil {
+		return time.Time{}, err
+	}
+
+	intermediaryAccount := types.IntermediaryAccount(delAddr, valAddr)
+	completionTime, err := k.stakingKeeper.Undelegate(ctx, intermediaryAccount, valAddr, shares)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	sdkBondTokens := sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), sdkBondAmount)
+	k.subDVPairTokens(ctx, delAddr, valAddr, amount, sdkBondTokens)
+	k.addDVPairUnbondingTokens(ctx, delAddr, valAddr, completionTime, amount, sdkBondTokens)
+
+	if err := k.jailBelowMinSelfDelegation(ctx, delAddr, valAddr); err != nil {
+		return time.Time{}, err
+	}
+
+	return completionTime, nil
+}
+
+// addDVPairUnbondingTokens adds bond tokens and sdkbond tokens to the
+// unbonding tokens of a DV pair with the given completion time
+func (k Keeper) addDVPairUnbondingTokens(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, completionTime time.Time, bondTokens, sdkBondTokens sdk.Coin,
+) {
+	tokens := types.UnbondingTokens{BondTokens: bondTokens, SDKBondTokens: sdkBondTokens}
+	if current, found := k.GetDVPairUnbondingTokens(ctx, delAddr, valAddr, completionTime); found {
+		tokens.BondTokens = tokens.BondTokens.Add(current.BondTokens)
+		tokens.SDKBondTokens = tokens.SDKBondTokens.Add(current.SDKBondTokens)
+	}
+	k.SetDVPairUnbondingTokens(ctx, delAddr, valAddr, completionTime, tokens)
+}
+
+// unbondShares returns the sdk delegation shares to unbond for an amount of
+// bond tokens of a DV pair, and the amount of sdkbond tokens minted for them,
+// which is the decrease of the bond tokens of the DV pair times the weight.
+// The shares are pro-rata to the sdkbond tokens of the DV pair, so that the
+// delegator bears its share of any slashing of the sdk delegation.
+func (k Keeper) unbondShares(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin,
+) (sdk.Dec, math.Int, error) {
+	bondTokens, found := k.GetDVPairBondTokens(ctx, delAddr, valAddr)
+	if !found {
+		return sdk.Dec{}, math.Int{}, stakingtypes.ErrNoDelegation
+	}
+	if amount.Amount.GT(bondTokens.Amount) {
+		return sdk.Dec{}, math.Int{}, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "undelegation amount %s exceeds the delegated %s", amount, bondTokens,
+		)
+	}
+
+	// a weight change leaves the sdkbond tokens of the DV pair stale until the
+	// reweighting reaches it, so they are synced before a partial unbonding is
+	// computed against them. A DV pair worth no sdkbond tokens is left as is,
+	// the unbonding of part of it fails below anyway.
+	weight, _ := k.GetBondTokenWeight(ctx, amount.Denom)
+	if amount.Amount.LT(bondTokens.Amount) && weight.MulInt(bondTokens.Amount).TruncateInt().IsPositive() {
+		if _, _, err := k.syncDVPairSDKBondTokens(ctx, delAddr, valAddr, bondTokens, weight); err != nil {
+			return sdk.Dec{}, math.Int{}, err
+		}
+	}
+	sdkBondTokens, _ := k.GetDVPairSDKBondTokens(ctx, delAddr, valAddr)
+
+	intermediaryAccount := types.IntermediaryAccount(delAddr, valAddr)
+	delegation, found := k.stakingKeeper.GetDelegation(ctx, intermediaryAccount, valAddr)
+	if !found {
+		return sdk.Dec{}, math.Int{}, stakingtypes.ErrNoDelegation
+	}
+
+	if amount.Amount.Equal(bondTokens.Amount) {
+		return delegation.Shares, sdkBondTokens.Amount, nil
+	}
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Dec{}, math.Int{}, stakingtypes.ErrNoValidatorFound
+	}
+
+	sdkBondAmount := weight.MulInt(bondTokens.Amount).TruncateInt().Sub(weight.MulInt(bondTokens.Amount.Sub(amount.Amount)).TruncateInt())
+	if sdkBondAmount.GTE(sdkBondTokens.Amount) {
+		return sdk.Dec{}, math.Int{}, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "the delegation left after undelegating %s is worth no sdkbond tokens", amount,
+		)
+	}
+
+	// the shares are computed directly rather than from their token value, as
+	// the rounding of the latter would shift the dust of the validator tokens
+	// onto the delegation left
+	shares := delegation.Shares.MulInt(sdkBondAmount).QuoInt(sdkBondTokens.Amount)
+	if !sdkBondAmount.IsPositive() || !validator.TokensFromShares(shares).TruncateInt().IsPositive() {
+		return sdk.Dec{}, math.Int{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "undelegation amount %s is too small", amount)
+	}
+
+	return shares, sdkBondAmount, nil
+}
+
+// CollectCompletedDelegations records the sdk unbonding delegations of
+// intermediary accounts which the staking module completes in this block, so
+// that their bond tokens can be unlocked in EndBlock.
+func (k Keeper) CollectCompletedDelegations(ctx sdk.Context) {
+	blockTime := ctx.BlockTime()
+	sdkBondDenom := k.stakingKeeper.BondDenom(ctx)
+
+	var completed []types.CompletedDelegation
+	seen := make(map[string]bool)
+
+	iterator := k.stakingKeeper.UBDQueueIterator(ctx, blockTime)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var timeslice stakingtypes.DVPairs
+		k.cdc.MustUnmarshal(iterator.Value(), &timeslice)
+
+		for _, dvPair := range timeslice.Pairs {
+			if seen[dvPair.String()] {
+				continue
+			}
+			seen[dvPair.String()] = true
+
+			intermediaryAccount := sdk.MustAccAddressFromBech32(dvPair.DelegatorAddress)
+			delAddr, found := k.GetIntermediaryAccountDelegator(ctx, intermediaryAccount)
+			if !found {
+				continue
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(dvPair.ValidatorAddress)
+			if err != nil {
+				panic(err)
+			}
+
+			ubd, found := k.stakingKeeper.GetUnbondingDelegation(ctx, intermediaryAccount, valAddr)
+			if !found {
+				continue
+			}
+
+			// entries are appended in order of creation, so the ones with the
+			// same completion time are next to each other
+			for _, entry := range ubd.Entries {
+				if !entry.IsMature(blockTime) {
+					continue
+				}
+
+				if n := len(completed); n > 0 &&
+					completed[n-1].DelegatorAddress == delAddr.String() &&
+					completed[n-1].ValidatorAddress == dvPair.ValidatorAddress &&
+					completed[n-1].CompletionTime.Equal(entry.CompletionTime) {
+					completed[n-1].Amount.Amount = completed[n-1].Amount.Amount.Add(entry.Balance)
+					continue
+				}
+
+				completed = append(completed, types.CompletedDelegation{
+					DelegatorAddress: delAddr.String(),
+					ValidatorAddress: dvPair.ValidatorAddress,
+					CompletionTime:   entry.CompletionTime,
+					Amount:           sdk.NewCoin(sdkBondDenom, entry.Balance),
+				})
+			}
+		}
+	}
+
+	if len(completed) > 0 {
+		k.SetCompletedDelegations(ctx, completed)
+	}
+}
+
+// CompleteUnbondings unlocks the bond tokens of the delegations completed by
+// the staking module in this block and burns the sdkbond tokens returned to
+// the intermediary accounts.
+func (k Keeper) CompleteUnbondings(ctx sdk.Context) error {
+	for _, completed := range k.GetCompletedDelegations(ctx) {
+		delAddr, err := sdk.AccAddressFromBech32(completed.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(completed.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+
+		unlocked, err := k.unlockAndBurn(ctx, delAddr, valAddr, completed.CompletionTime, completed.Amount)
+		if err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCompleteUnbonding,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, unlocked.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, completed.ValidatorAddress),
+				sdk.NewAttribute(types.AttributeKeyDelegator, completed.DelegatorAddress),
+			),
+		)
+	}
+
+	k.DeleteCompletedDelegations(ctx)
+
+	return nil
+}
+
+// unlockAndBurn burns the sdkbond tokens returned to the intermediary account
+// of a DV pair by the unbonding entries completing at the given time, sends the
+// bond tokens backing them back to the delegator and removes the unbonding
+// tokens record, retiring its sdkbond tokens. It returns the unlocked bond
+// tokens.
+//
+// The bond tokens are unlocked pro-rata to the sdkbond tokens actually
+// returned, at the rate of the unbonding tokens record. The remainder backs
+// the sdkbond tokens lost to slashing and is sent to the unbonding remainder
+// recipient, or burned if there is none.
+func (k Keeper) unlockAndBurn(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, completionTime time.Time, sdkBondTokens sdk.Coin,
+) (sdk.Coin, error) {
+	tokens, found := k.GetDVPairUnbondingTokens(ctx, delAddr, valAddr, completionTime)
+	if !found {
+		return sdk.Coin{}, sdkerrors.Wrapf(
+			stakingtypes.ErrNoUnbondingDelegation, "delegator %s validator %s completion time %s", delAddr, valAddr, completionTime,
+		)
+	}
+
+	unlockAmount := tokens.BondTokens.Amount
+	if sdkBondTokens.Amount.LT(tokens.SDKBondTokens.Amount) {
+		unlockAmount = unlockAmount.Mul(sdkBondTokens.Amount).Quo(tokens.SDKBondTokens.Amount)
+	}
+	unlocked := sdk.NewCoin(tokens.BondTokens.Denom, unlockAmount)
+
+	intermediaryAccount := types.IntermediaryAccount(delAddr, valAddr)
+	if sdkBondTokens.IsPositive() {
+		burnCoins := sdk.NewCoins(sdkBondTokens)
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, intermediaryAccount, types.ModuleName, burnCoins); err != nil {
+			return sdk.Coin{}, err
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnCoins); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	// the bond tokens are undelegated, so that vesting accounts stop tracking
+	// them as delegated
+	if unlocked.IsPositive() {
+		if err := k.bankKeeper.UndelegateCoins(ctx, intermediaryAccount, delAddr, sdk.NewCoins(unlocked)); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	if remainder := tokens.BondTokens.Sub(unlocked); remainder.IsPositive() {
+		if err := k.handleUnbondingRemainder(ctx, intermediaryAccount, remainder); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	k.DeleteDVPairUnbondingTokens(ctx, delAddr, valAddr, completionTime)
+	k.addIssuedSDKBondTokens(ctx, tokens.SDKBondTokens.Amount.Neg())
+
+	return unlocked, nil
+}
+
+// handleUnbondingRemainder sends the bond tokens of an intermediary account
+// which back slashed sdkbond tokens to the unbonding remainder recipient, or
+// burns them if there is none
+func (k Keeper) handleUnbondingRemainder(ctx sdk.Context, intermediaryAccount sdk.AccAddress, remainder sdk.Coin) error {
+	coins := sdk.NewCoins(remainder)
+	if recipient := k.UnbondingRemainderRecipient(ctx); recipient != nil {
+		return k.bankKeeper.SendCoins(ctx, intermediaryAccount, recipient, coins)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, intermediaryAccount, types.ModuleName, coins); err != nil {
+		return err
+	}
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
+}
+
+// subDVPairTokens subtracts bond tokens and sdkbond tokens from the records of
+// a DV pair, removing the records once they are empty
+func (k Keeper) subDVPairTokens(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, bondTokens, sdkBondTokens sdk.Coin) {
+	if current, found := k.GetDVPairBondTokens(ctx, delAddr, valAddr); found {
+		if remaining := current.Sub(bondTokens); remaining.IsPositive() {
+			k.SetDVPairBondTokens(ctx, delAddr, valAddr, remaining)
+		} else {
+			k.DeleteDVPairBondTokens(ctx, delAddr, valAddr)
+		}
+	}
+
+	if current, found := k.GetDVPairSDKBondTokens(ctx, delAddr, valAddr); found {
+		if remaining := current.Sub(sdkBondTokens); remaining.IsPositive() {
+			k.SetDVPairSDKBondTokens(ctx, delAddr, valAddr, remaining)
+		} else {
+			k.DeleteDVPairSDKBondTokens(ctx, delAddr, valAddr)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+func (suite *KeeperTestSuite) TestMsgUndelegate() {
+	delegated := sdk.NewInt64Coin(bondDenom, 1000)
+
+	testCases := []struct {
+		name           string
+		amount         sdk.Coin
+		expectSDKBond  sdk.Int
+		skipDelegation bool
+		expectErr      error
+	}{
+		{
+			name:          "undelegate all",
+			amount:        delegated,
+			expectSDKBond: sdk.NewInt(500),
+		},
+		{
+			name:          "undelegate part",
+			amount:        sdk.NewInt64Coin(bondDenom, 300),
+			expectSDKBond: sdk.NewInt(150),
+		},
+		{
+			name:      "more than delegated",
+			amount:    sdk.NewInt64Coin(bondDenom, 1001),
+			expectErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name:      "not the validator bond denom",
+			amount:    sdk.NewInt64Coin("uother", 1000),
+			expectErr: types.ErrInvalidBondDenom,
+		},
+		{
+			name:           "no delegation",
+			amount:         delegated,
+			skipDelegation: true,
+			expectErr:      stakingtypes.ErrNoDelegation,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			valAddr := suite.validator.GetOperator()
+			delAddr := suite.fundedAccount(sdk.NewCoins(delegated))
+			if !tc.skipDelegation {
+				_, err := suite.msgServer.Delegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgDelegate(delAddr, valAddr, delegated))
+				suite.Require().NoError(err)
+			}
+
+			res, err := suite.msgServer.Undelegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgUndelegate(delAddr, valAddr, tc.amount))
+			if tc.expectErr != nil {
+				suite.Require().ErrorIs(err, tc.expectErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			unbondingTime := suite.app.StakingKeeper.UnbondingTime(suite.ctx)
+			suite.Require().Equal(suite.ctx.BlockTime().Add(unbondingTime), res.CompletionTime)
+
+			intermediaryAccount := types.IntermediaryAccount(delAddr, valAddr)
+			ubd, found := suite.app.StakingKeeper.GetUnbondingDelegation(suite.ctx, intermediaryAccount, valAddr)
+			suite.Require().True(found)
+			suite.Require().Len(ubd.Entries, 1)
+			suite.Require().Equal(tc.expectSDKBond, ubd.Entries[0].Balance)
+
+			// the bond tokens stay locked until the unbonding completes
+			suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, delAddr, bondDenom).IsZero())
+			suite.Require().Equal(delegated, suite.app.BankKeeper.GetBalance(suite.ctx, intermediaryAccount, bondDenom))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCompleteUnbonding() {
+	suite.disableInflation()
+	valAddr := suite.validator.GetOperator()
+	sdkBondDenom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	sdkBondSupply := suite.app.BankKeeper.GetSupply(suite.ctx, sdkBondDenom)
+
+	delegated := sdk.NewInt64Coin(bondDenom, 1001)
+	delAddr := suite.fundedAccount(sdk.NewCoins(delegated))
+	intermediaryAccount := types.IntermediaryAccount(delAddr, valAddr)
+
+	_, err := suite.msgServer.Delegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgDelegate(delAddr, valAddr, delegated))
+	suite.Require().NoError(err)
+
+	// two unbondings maturing at different times, the first one is backed by
+	// 200stake and the second one by the remaining 300stake
+	first, err := suite.msgServer.Undelegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgUndelegate(delAddr, valAddr, sdk.NewInt64Coin(bondDenom, 400)))
+	suite.Require().NoError(err)
+
+	bondTokens, _ := suite.msKeeper.GetDVPairBondTokens(suite.ctx, delAddr, valAddr)
+	suite.Require().Equal(sdk.NewInt64Coin(bondDenom, 601), bondTokens)
+	sdkBondTokens, _ := suite.msKeeper.GetDVPairSDKBondTokens(suite.ctx, delAddr, valAddr)
+	suite.Require().Equal(sdk.NewInt64Coin(sdkBondDenom, 300), sdkBondTokens)
+
+	suite.nextBlock(suite.ctx.BlockTime().Add(time.Hour))
+	second, err := suite.msgServer.Undelegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgUndelegate(delAddr, valAddr, sdk.NewInt64Coin(bondDenom, 601)))
+	suite.Require().NoError(err)
+
+	_, found := suite.msKeeper.GetDVPairBondTokens(suite.ctx, delAddr, valAddr)
+	suite.Require().False(found)
+	_, found = suite.msKeeper.GetDVPairSDKBondTokens(suite.ctx, delAddr, valAddr)
+	suite.Require().False(found)
+	unbondingTokens, _ := suite.msKeeper.GetDVPairUnbondingTokens(suite.ctx, delAddr, valAddr, second.CompletionTime)
+	suite.Require().Equal(types.UnbondingTokens{
+		BondTokens:    sdk.NewInt64Coin(bondDenom, 601),
+		SDKBondTokens: sdk.NewInt64Coin(sdkBondDenom, 300),
+	}, unbondingTokens)
+
+	// nothing is unlocked before the unbonding time
+	events := suite.nextBlock(first.CompletionTime.Add(-time.Second))
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, delAddr, bondDenom).IsZero())
+	for _, event := range events {
+		suite.Require().NotEqual(types.EventTypeCompleteUnbonding, event.Type)
+	}
+
+	events = suite.nextBlock(first.CompletionTime)
+	suite.Require().Equal(sdk.NewInt64Coin(bondDenom, 400), suite.app.BankKeeper.GetBalance(suite.ctx, delAddr, bondDenom))
+	suite.Require().Equal(sdk.NewInt64Coin(bondDenom, 601), suite.app.BankKeeper.GetBalance(suite.ctx, intermediaryAccount, bondDenom))
+	// the sdk staking module emits its own complete_unbonding event for the intermediary account
+	expectedEvent := sdk.NewEvent(
+		types.EventTypeCompleteUnbonding,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewInt64Coin(bondDenom, 400).String()),
+		sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+	)
+	suite.Require().Contains(events, abci.Event(expectedEvent))
+	suite.Require().Empty(suite.msKeeper.GetCompletedDelegations(suite.ctx))
+	_, found = suite.msKeeper.GetDVPairUnbondingTokens(suite.ctx, delAddr, valAddr, first.CompletionTime)
+	suite.Require().False(found)
+
+	// the last unbonding unlocks the remaining bond tokens, including the
+	// ones lost to truncation when minting
+	suite.nextBlock(second.CompletionTime)
+	suite.Require().Equal(delegated, suite.app.BankKeeper.GetBalance(suite.ctx, delAddr, bondDenom))
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, intermediaryAccount).IsZero())
+	_, found = suite.msKeeper.GetDVPairUnbondingTokens(suite.ctx, delAddr, valAddr, second.CompletionTime)
+	suite.Require().False(found)
+
+	// all the minted sdkbond tokens are burned
+	suite.Require().Equal(sdkBondSupply, suite.app.BankKeeper.GetSupply(suite.ctx, sdkBondDenom))
+}
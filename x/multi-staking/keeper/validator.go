@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// CreateValidator pins a new validator to its bond denom and creates it in the
+// sdk staking module. The initial self-delegation is made by the intermediary
+// account of the operator with the sdkbond tokens minted for the locked bond tokens.
+func (k Keeper) CreateValidator(ctx sdk.Context, msg *types.MsgCreateValidator) error {
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+
+	if _, found := k.stakingKeeper.GetValidator(ctx, valAddr); found {
+		return stakingtypes.ErrValidatorOwnerExists
+	}
+	if _, found := k.GetValidatorBondDenom(ctx, valAddr); found {
+		return stakingtypes.ErrValidatorOwnerExists
+	}
+
+	if !k.IsBondDenom(ctx, msg.BondDenom) {
+		return sdkerrors.Wrapf(types.ErrInvalidBondDenom, "%s", msg.BondDenom)
+	}
+	if msg.Value.Denom != msg.BondDenom {
+		return sdkerrors.Wrapf(types.ErrMismatchedValidatorBondDenom, "got %s, expected %s", msg.Value.Denom, msg.BondDenom)
+	}
+
+	k.SetValidatorBondDenom(ctx, valAddr, msg.BondDenom)
+
+	intermediaryAccount, sdkBondAmount, err := k.lockAndMint(ctx, delAddr, valAddr, msg.Value)
+	if err != nil {
+		return err
+	}
+
+	if sdkBondAmount.LT(msg.MinSelfDelegation) {
+		return stakingtypes.ErrSelfDelegationBelowMinimum
+	}
+
+	sdkMsg := &stakingtypes.MsgCreateValidator{
+		Description:       msg.Description,
+		Commission:        msg.Commission,
+		MinSelfDelegation: msg.MinSelfDelegation,
+		DelegatorAddress:  intermediaryAccount.String(),
+		ValidatorAddress:  msg.ValidatorAddress,
+		Pubkey:            msg.Pubkey,
+		Value:             sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), sdkBondAmount),
+	}
+
+	// the events of the sdk staking module refer to the intermediary account
+	// and the sdkbond tokens, the multi-staking msg server emits its own ones
+	stakingCtx := ctx.WithEventManager(sdk.NewEventManager())
+	_, err = stakingkeeper.NewMsgServerImpl(k.stakingKeeper).CreateValidator(sdk.WrapSDKContext(stakingCtx), sdkMsg)
+	return err
+}
+
+// SelfDelegationTokens returns the sdkbond tokens self-delegated to a validator,
+// which are the tokens of the sdk delegation of the intermediary account of its
+// operator. It returns false if the operator has no delegation left.
+func (k Keeper) SelfDelegationTokens(ctx sdk.Context, validator stakingtypes.Validator) (math.Int, bool) {
+	valAddr := validator.GetOperator()
+	intermediaryAccount := types.IntermediaryAccount(sdk.AccAddress(valAddr), valAddr)
+	delegation, found := k.stakingKeeper.GetDelegation(ctx, intermediaryAccount, valAddr)
+	if !found {
+		return math.ZeroInt(), false
+	}
+	return validator.TokensFromShares(delegation.Shares).TruncateInt(), true
+}
+
+// Unjail unjails a validator once its jail period has passed, like the
+// slashing module does, but with the self-delegation of the intermediary
+// account of its operator, since the operator itself has no sdk delegation.
+// The validators of a sunsetting bond denom stay jailed until the denom is
+// removed, since the sunset jails them without a jail period.
+func (k Keeper) Unjail(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return slashingtypes.ErrNoValidatorForAddress
+	}
+
+	if denom, found := k.GetValidatorBondDenom(ctx, valAddr); found && k.IsBondDenomSunsetting(ctx, denom) {
+		return sdkerrors.Wrapf(types.ErrBondDenomSunsetting, "validator %s is pinned to %s", valAddr, denom)
+	}
+
+	tokens, found := k.SelfDelegationTokens(ctx, validator)
+	if !found {
+		return slashingtypes.ErrMissingSelfDelegation
+	}
+	if tokens.LT(validator.MinSelfDelegation) {
+		return sdkerrors.Wrapf(
+			slashingtypes.ErrSelfDelegationTooLowToUnjail, "%s less than %s", tokens, validator.MinSelfDelegation,
+		)
+	}
+
+	if !validator.IsJailed() {
+		return slashingtypes.ErrValidatorNotJailed
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+	// a validator without signing info was never bonded, and was jailed for
+	// falling below its min self delegation
+	if info, found := k.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr); found {
+		if info.Tombstoned || ctx.BlockHeader().Time.Before(info.JailedUntil) {
+			return slashingtypes.ErrValidatorJailed
+		}
+	}
+
+	k.stakingKeeper.Unjail(ctx, consAddr)
+	return nil
+}
+
+// jailBelowMinSelfDelegation jails a validator whose operator unbonded its
+// self-delegation below the min self delegation, as the staking module does
+// for the self-delegations it knows about.
+func (k Keeper) jailBelowMinSelfDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	if !delAddr.Equals(sdk.AccAddress(valAddr)) {
+		return nil
+	}
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found || validator.IsJailed() {
+		return nil
+	}
+
+	if tokens, _ := k.SelfDelegationTokens(ctx, validator); tokens.GTE(validator.MinSelfDelegation) {
+		return nil
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+	k.stakingKeeper.Jail(ctx, consAddr)
+	return nil
+}
@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	"github.com/notional-labs/multi-staking-module/testing/simapp"
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// vestingAccount creates a vesting account of the given bond tokens, none of
+// which vests within a year
+func (suite *KeeperTestSuite) vestingAccount(newAccount func(*authtypes.BaseAccount, sdk.Coins, time.Time) authtypes.AccountI, vesting sdk.Coins) sdk.AccAddress {
+	_, _, addr := testdata.KeyTestPubAddr()
+	suite.app.AccountKeeper.SetAccount(suite.ctx, newAccount(authtypes.NewBaseAccountWithAddress(addr), vesting, suite.ctx.BlockTime()))
+	suite.Require().NoError(simapp.FundAccount(suite.app, suite.ctx, addr, vesting))
+	return addr
+}
+
+func (suite *KeeperTestSuite) TestDelegateVestingBondTokens() {
+	const year = 365 * 24 * time.Hour
+
+	testCases := []struct {
+		name       string
+		newAccount func(*authtypes.BaseAccount, sdk.Coins, time.Time) authtypes.AccountI
+	}{
+		{
+			name: "continuous vesting account",
+			newAccount: func(acc *authtypes.BaseAccount, vesting sdk.Coins, start time.Time) authtypes.AccountI {
+				return vestingtypes.NewContinuousVestingAccount(acc, vesting, start.Unix(), start.Add(year).Unix())
+			},
+		},
+		{
+			name: "delayed vesting account",
+			newAccount: func(acc *authtypes.BaseAccount, vesting sdk.Coins, start time.Time) authtypes.AccountI {
+				return vestingtypes.NewDelayedVestingAccount(acc, vesting, start.Add(year).Unix())
+			},
+		},
+		{
+			name: "periodic vesting account",
+			newAccount: func(acc *authtypes.BaseAccount, vesting sdk.Coins, start time.Time) authtypes.AccountI {
+				half := vesting.QuoInt(sdk.NewInt(2))
+				return vestingtypes.NewPeriodicVestingAccount(acc, vesting, start.Unix(), vestingtypes.Periods{
+					{Length: int64(year.Seconds()), Amount: half},
+					{Length: int64(year.Seconds()), Amount: vesting.Sub(half...)},
+				})
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.ctx = suite.ctx.WithBlockTime(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+			valAddr := suite.validator.GetOperator()
+			vesting := sdk.NewInt64Coin(bondDenom, 1000)
+			delAddr := suite.vestingAccount(tc.newAccount, sdk.NewCoins(vesting))
+			intermediaryAccount := types.IntermediaryAccount(delAddr, valAddr)
+			suite.Require().True(suite.app.BankKeeper.SpendableCoins(suite.ctx, delAddr).IsZero())
+
+			// the vesting bond tokens are locked and tracked as delegated vesting
+			_, err := suite.msgServer.Delegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgDelegate(delAddr, valAddr, vesting))
+			suite.Require().NoError(err)
+			suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, delAddr, bondDenom).IsZero())
+			suite.Require().Equal(vesting, suite.app.BankKeeper.GetBalance(suite.ctx, intermediaryAccount, bondDenom))
+			vacc := suite.app.AccountKeeper.GetAccount(suite.ctx, delAddr).(vestingexported.VestingAccount)
+			suite.Require().Equal(sdk.NewCoins(vesting), vacc.GetDelegatedVesting())
+			suite.Require().True(vacc.GetDelegatedFree().IsZero())
+
+			// the unlocked bond tokens are no longer tracked as delegated and are
+			// locked again by the vesting schedule
+			res, err := suite.msgServer.Undelegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgUndelegate(delAddr, valAddr, vesting))
+			suite.Require().NoError(err)
+			suite.nextBlock(res.CompletionTime)
+
+			suite.Require().Equal(vesting, suite.app.BankKeeper.GetBalance(suite.ctx, delAddr, bondDenom))
+			vacc = suite.app.AccountKeeper.GetAccount(suite.ctx, delAddr).(vestingexported.VestingAccount)
+			suite.Require().True(vacc.GetDelegatedVesting().IsZero())
+			suite.Require().True(vacc.GetDelegatedFree().IsZero())
+			locked := vacc.GetVestingCoins(suite.ctx.BlockTime())
+			suite.Require().False(locked.IsZero())
+			suite.Require().Equal(locked, suite.app.BankKeeper.LockedCoins(suite.ctx, delAddr))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConvertDelegatedVestingBondTokens() {
+	suite.ctx = suite.ctx.WithBlockTime(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+	suite.msKeeper.SetBondTokenWeight(suite.ctx, otherBondDenom, sdk.OneDec())
+	valAddr := suite.validator.GetOperator()
+	otherValAddr := suite.createValidator(sdk.NewInt64Coin(otherBondDenom, 1000))
+
+	vesting := sdk.NewInt64Coin(bondDenom, 1000)
+	delAddr := suite.vestingAccount(func(acc *authtypes.BaseAccount, vesting sdk.Coins, start time.Time) authtypes.AccountI {
+		return vestingtypes.NewDelayedVestingAccount(acc, vesting, start.Add(time.Hour).Unix())
+	}, sdk.NewCoins(vesting))
+	_, err := suite.msgServer.Delegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgDelegate(delAddr, valAddr, vesting))
+	suite.Require().NoError(err)
+
+	// converting would unlock the delegated vesting tokens in another denom, even
+	// once they are vested
+	suite.nextBlock(suite.ctx.BlockTime().Add(2 * time.Hour))
+	_, err = suite.msgServer.BeginRedelegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgBeginRedelegate(delAddr, valAddr, otherValAddr, vesting, true))
+	suite.Require().ErrorIs(err, types.ErrConvertDelegatedVesting)
+}
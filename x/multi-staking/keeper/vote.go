@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// Vote casts a vote on a gov proposal from the intermediary accounts of all
+// the DV pairs of a delegator, so that the gov tally counts their sdk
+// delegations as the voting power of the delegator. Like a gov vote, it
+// overrides the vote the validators cast for the intermediary accounts.
+func (k Keeper) Vote(
+	ctx sdk.Context, voter sdk.AccAddress, proposalID uint64, options govv1.WeightedVoteOptions, metadata string,
+) error {
+	var valAddrs []sdk.ValAddress
+	k.IterateDelegatorDVPairBondTokens(ctx, voter, func(valAddr sdk.ValAddress, _ sdk.Coin) bool {
+		valAddrs = append(valAddrs, valAddr)
+		return false
+	})
+	if len(valAddrs) == 0 {
+		return sdkerrors.Wrapf(stakingtypes.ErrNoDelegation, "%s has no multi-staking delegation", voter)
+	}
+
+	for _, valAddr := range valAddrs {
+		intermediaryAccount := types.IntermediaryAccount(voter, valAddr)
+		if err := k.govKeeper.AddVote(ctx, proposalID, intermediaryAccount, options, metadata); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVote,
+			sdk.NewAttribute(types.AttributeKeyVoter, voter.String()),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyOption, options.String()),
+		),
+	)
+
+	return nil
+}
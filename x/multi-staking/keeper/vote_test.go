@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// votingProposal submits a proposal without messages and starts its voting period
+func (suite *KeeperTestSuite) votingProposal() govv1.Proposal {
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, []sdk.Msg{}, "")
+	suite.Require().NoError(err)
+	suite.app.GovKeeper.ActivateVotingPeriod(suite.ctx, proposal)
+	proposal, _ = suite.app.GovKeeper.GetProposal(suite.ctx, proposal.Id)
+	return proposal
+}
+
+func (suite *KeeperTestSuite) TestVote() {
+	valAddr := suite.validator.GetOperator()
+	delegated := sdk.NewInt64Coin(bondDenom, 2_000_000)
+	delAddr := suite.fundedAccount(sdk.NewCoins(delegated))
+	_, err := suite.msgServer.Delegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgDelegate(delAddr, valAddr, delegated))
+	suite.Require().NoError(err)
+	sdkBondTokens, _ := suite.msKeeper.GetDVPairSDKBondTokens(suite.ctx, delAddr, valAddr)
+	validator, _ := suite.app.StakingKeeper.GetValidator(suite.ctx, valAddr)
+
+	// the validator votes no, which is inherited by the intermediary account
+	proposal := suite.votingProposal()
+	suite.Require().NoError(suite.app.GovKeeper.AddVote(
+		suite.ctx, proposal.Id, sdk.AccAddress(valAddr), govv1.NewNonSplitVoteOption(govv1.OptionNo), "",
+	))
+	_, _, tally := suite.app.GovKeeper.Tally(suite.ctx, proposal)
+	suite.Require().Equal(validator.GetBondedTokens().String(), tally.NoCount)
+	suite.Require().Equal("0", tally.YesCount)
+
+	// the vote of the delegator overrides the vote of the validator for its
+	// multi-staking delegation
+	proposal = suite.votingProposal()
+	suite.Require().NoError(suite.app.GovKeeper.AddVote(
+		suite.ctx, proposal.Id, sdk.AccAddress(valAddr), govv1.NewNonSplitVoteOption(govv1.OptionNo), "",
+	))
+	_, err = suite.msgServer.Vote(sdk.WrapSDKContext(suite.ctx), types.NewMsgVote(delAddr, proposal.Id, govv1.OptionYes, ""))
+	suite.Require().NoError(err)
+	_, _, tally = suite.app.GovKeeper.Tally(suite.ctx, proposal)
+	suite.Require().Equal(sdkBondTokens.Amount.String(), tally.YesCount)
+	suite.Require().Equal(validator.GetBondedTokens().Sub(sdkBondTokens.Amount).String(), tally.NoCount)
+}
+
+func (suite *KeeperTestSuite) TestVoteWeighted() {
+	// the delegator delegates to two validators of the bond denom
+	valAddrs := []sdk.ValAddress{
+		suite.validator.GetOperator(),
+		suite.createValidator(sdk.NewInt64Coin(bondDenom, 2_000_000)),
+	}
+	suite.nextBlock(suite.ctx.BlockTime())
+
+	delAddr := suite.fundedAccount(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 3_000_000)))
+	totalSDKBond := sdk.ZeroInt()
+	for i, valAddr := range valAddrs {
+		delegated := sdk.NewInt64Coin(bondDenom, int64(i+1)*1_000_000)
+		_, err := suite.msgServer.Delegate(sdk.WrapSDKContext(suite.ctx), types.NewMsgDelegate(delAddr, valAddr, delegated))
+		suite.Require().NoError(err)
+		sdkBondTokens, _ := suite.msKeeper.GetDVPairSDKBondTokens(suite.ctx, delAddr, valAddr)
+		totalSDKBond = totalSDKBond.Add(sdkBondTokens.Amount)
+	}
+
+	proposal := suite.votingProposal()
+	options := govv1.WeightedVoteOptions{
+		govv1.NewWeightedVoteOption(govv1.OptionYes, sdk.NewDecWithPrec(6, 1)),
+		govv1.NewWeightedVoteOption(govv1.OptionNo, sdk.NewDecWithPrec(4, 1)),
+	}
+	_, err := suite.msgServer.VoteWeighted(sdk.WrapSDKContext(suite.ctx), types.NewMsgVoteWeighted(delAddr, proposal.Id, options, ""))
+	suite.Require().NoError(err)
+
+	// both intermediary accounts voted, and the validators did not
+	for _, valAddr := range valAddrs {
+		vote, found := suite.app.GovKeeper.GetVote(suite.ctx, proposal.Id, types.IntermediaryAccount(delAddr, valAddr))
+		suite.Require().True(found)
+		suite.Require().Equal(options, govv1.WeightedVoteOptions(vote.Options))
+	}
+	_, _, tally := suite.app.GovKeeper.Tally(suite.ctx, proposal)
+	suite.Require().Equal(sdk.NewDecFromInt(totalSDKBond).Mul(sdk.NewDecWithPrec(6, 1)).TruncateInt().String(), tally.YesCount)
+	suite.Require().Equal(sdk.NewDecFromInt(totalSDKBond).Mul(sdk.NewDecWithPrec(4, 1)).TruncateInt().String(), tally.NoCount)
+}
+
+func (suite *KeeperTestSuite) TestVoteWithoutDelegation() {
+	proposal := suite.votingProposal()
+	delAddr := suite.fundedAccount(sdk.NewCoins())
+	_, err := suite.msgServer.Vote(sdk.WrapSDKContext(suite.ctx), types.NewMsgVote(delAddr, proposal.Id, govv1.OptionYes, ""))
+	suite.Require().ErrorIs(err, stakingtypes.ErrNoDelegation)
+}
@@ -0,0 +1,35 @@
+package multistaking
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/keeper"
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// NewProposalHandler creates a governance handler to manage the bond denoms,
+// their weights, their weight bounds, their staking caps and the conversion
+// reserve.
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.AddBondDenomProposal:
+			return keeper.HandleAddBondDenomProposal(ctx, k, c)
+		case *types.ChangeBondTokenWeightProposal:
+			return keeper.HandleChangeBondTokenWeightProposal(ctx, k, c)
+		case *types.RemoveBondTokenProposal:
+			return keeper.HandleRemoveBondTokenProposal(ctx, k, c)
+		case *types.SetStakingCapsProposal:
+			return keeper.HandleSetStakingCapsProposal(ctx, k, c)
+		case *types.SetWeightBoundsProposal:
+			return keeper.HandleSetWeightBoundsProposal(ctx, k, c)
+		case *types.ConversionReserveSpendProposal:
+			return keeper.HandleConversionReserveSpendProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized multi-staking proposal content type: %T", c)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding multi-staking type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.BondTokenWeightKey):
+			var weightA, weightB sdk.DecProto
+
+			cdc.MustUnmarshal(kvA.Value, &weightA)
+			cdc.MustUnmarshal(kvB.Value, &weightB)
+
+			return fmt.Sprintf("%v\n%v", weightA, weightB)
+		case bytes.Equal(kvA.Key[:1], types.ValidatorBondDenomKey):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.IntermediaryAccountDelegatorKey):
+			return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.DVPairSDKBondTokenKey),
+			bytes.Equal(kvA.Key[:1], types.DVPairBondTokenKey):
+			var coinA, coinB sdk.Coin
+
+			cdc.MustUnmarshal(kvA.Value, &coinA)
+			cdc.MustUnmarshal(kvB.Value, &coinB)
+
+			return fmt.Sprintf("%v\n%v", coinA, coinB)
+		case bytes.Equal(kvA.Key[:1], types.DVPairUnbondingTokensKey):
+			var tokensA, tokensB types.UnbondingTokens
+
+			cdc.MustUnmarshal(kvA.Value, &tokensA)
+			cdc.MustUnmarshal(kvB.Value, &tokensB)
+
+			return fmt.Sprintf("%v\n%v", tokensA, tokensB)
+		case bytes.Equal(kvA.Key[:1], types.BondDenomSunsetHeightKey):
+			return fmt.Sprintf("%d\n%d", int64(sdk.BigEndianToUint64(kvA.Value)), int64(sdk.BigEndianToUint64(kvB.Value)))
+
+		case bytes.Equal(kvA.Key[:1], types.ReweightingKey):
+			var reweightingA, reweightingB types.Reweighting
+
+			cdc.MustUnmarshal(kvA.Value, &reweightingA)
+			cdc.MustUnmarshal(kvB.Value, &reweightingB)
+
+			return fmt.Sprintf("%v\n%v", reweightingA, reweightingB)
+		case bytes.Equal(kvA.Key[:1], types.StakingCapsKey):
+			var capsA, capsB types.StakingCaps
+
+			cdc.MustUnmarshal(kvA.Value, &capsA)
+			cdc.MustUnmarshal(kvB.Value, &capsB)
+
+			return fmt.Sprintf("%v\n%v", capsA, capsB)
+		case bytes.Equal(kvA.Key[:1], types.BondedTokensKey),
+			bytes.Equal(kvA.Key[:1], types.IssuedSDKBondTokensKey):
+			var amountA, amountB sdk.IntProto
+
+			cdc.MustUnmarshal(kvA.Value, &amountA)
+			cdc.MustUnmarshal(kvB.Value, &amountB)
+
+			return fmt.Sprintf("%v\n%v", amountA, amountB)
+		case bytes.Equal(kvA.Key[:1], types.WeightBoundsKey):
+			var boundsA, boundsB types.WeightBounds
+
+			cdc.MustUnmarshal(kvA.Value, &boundsA)
+			cdc.MustUnmarshal(kvB.Value, &boundsB)
+
+			return fmt.Sprintf("%v\n%v", boundsA, boundsB)
+		case bytes.Equal(kvA.Key[:1], types.BondDenomUsesKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.SunsetCursorKey):
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+
+		default:
+			panic(fmt.Sprintf("invalid multi-staking key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// Simulation parameter constants
+const (
+	BondTokenWeights     = "bond_token_weights"
+	ReweightingBatchSize = "reweighting_batch_size"
+	WeightEpochLength    = "weight_epoch_length"
+	SunsetBatchSize      = "sunset_batch_size"
+	SDKBondTransferable  = "sdk_bond_transferable"
+)
+
+// GenBondTokenWeights returns between one and four random bond denoms with
+// random weights
+func GenBondTokenWeights(r *rand.Rand) []types.BondTokenWeight {
+	weights := make([]types.BondTokenWeight, simtypes.RandIntBetween(r, 1, 5))
+	for i := range weights {
+		weights[i] = types.BondTokenWeight{
+			// the index keeps the denoms unique
+			BondDenom:       fmt.Sprintf("u%s%d", strings.ToLower(simtypes.RandStringOfLength(r, 4)), i),
+			BondTokenWeight: GenBondTokenWeight(r),
+		}
+	}
+	return weights
+}
+
+// GenBondTokenWeight returns a random bond token weight between 0.01 and 2
+func GenBondTokenWeight(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 201)), 2)
+}
+
+// GenReweightingBatchSize returns a random ReweightingBatchSize
+func GenReweightingBatchSize(r *rand.Rand) uint32 {
+	return uint32(simtypes.RandIntBetween(r, 1, 200))
+}
+
+// GenWeightEpochLength returns a random WeightEpochLength
+func GenWeightEpochLength(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 100))
+}
+
+// GenSunsetBatchSize returns a random SunsetBatchSize
+func GenSunsetBatchSize(r *rand.Rand) uint32 {
+	return uint32(simtypes.RandIntBetween(r, 1, 200))
+}
+
+// GenSDKBondTransferable returns a random SDKBondTransferable
+func GenSDKBondTransferable(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
+// RandomizedGenState generates a random GenesisState for multi-staking. The
+// validators of the staking genesis state are pinned to random bond denoms,
+// so the staking module must generate its genesis state first.
+func RandomizedGenState(simState *module.SimulationState) {
+	var bondTokenWeights []types.BondTokenWeight
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BondTokenWeights, &bondTokenWeights, simState.Rand,
+		func(r *rand.Rand) { bondTokenWeights = GenBondTokenWeights(r) },
+	)
+
+	var reweightingBatchSize uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ReweightingBatchSize, &reweightingBatchSize, simState.Rand,
+		func(r *rand.Rand) { reweightingBatchSize = GenReweightingBatchSize(r) },
+	)
+
+	var weightEpochLength uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, WeightEpochLength, &weightEpochLength, simState.Rand,
+		func(r *rand.Rand) { weightEpochLength = GenWeightEpochLength(r) },
+	)
+
+	var sunsetBatchSize uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SunsetBatchSize, &sunsetBatchSize, simState.Rand,
+		func(r *rand.Rand) { sunsetBatchSize = GenSunsetBatchSize(r) },
+	)
+
+	var sdkBondTransferable bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SDKBondTransferable, &sdkBondTransferable, simState.Rand,
+		func(r *rand.Rand) { sdkBondTransferable = GenSDKBondTransferable(r) },
+	)
+
+	var stakingGenesis stakingtypes.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[stakingtypes.ModuleName], &stakingGenesis)
+
+	validatorBondDenoms := make([]types.ValidatorBondDenom, len(stakingGenesis.Validators))
+	for i, validator := range stakingGenesis.Validators {
+		validatorBondDenoms[i] = types.ValidatorBondDenom{
+			ValidatorAddress: validator.OperatorAddress,
+			BondDenom:        bondTokenWeights[simState.Rand.Intn(len(bondTokenWeights))].BondDenom,
+		}
+	}
+
+	params := types.NewParams(
+		types.DefaultUnbondingRemainderRecipient, reweightingBatchSize, weightEpochLength, sunsetBatchSize, sdkBondTransferable,
+	)
+	multiStakingGenesis := types.NewGenesisState(params, bondTokenWeights, validatorBondDenoms)
+
+	bz, err := json.MarshalIndent(&multiStakingGenesis.BondTokenWeights, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated multi-staking bond token weights:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(multiStakingGenesis)
+}
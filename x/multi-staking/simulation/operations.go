@@ -0,0 +1,459 @@
+package simulation
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/keeper"
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// Simulation operation weights constants
+//
+// NOTE: the keys differ from the ones of the staking module, since both share
+// the simulation app params.
+const (
+	OpWeightMsgCreateValidator           = "op_weight_msg_multistaking_create_validator"            //nolint:gosec
+	OpWeightMsgDelegate                  = "op_weight_msg_multistaking_delegate"                    //nolint:gosec
+	OpWeightMsgUndelegate                = "op_weight_msg_multistaking_undelegate"                  //nolint:gosec
+	OpWeightMsgBeginRedelegate           = "op_weight_msg_multistaking_begin_redelegate"            //nolint:gosec
+	OpWeightMsgCancelUnbondingDelegation = "op_weight_msg_multistaking_cancel_unbonding_delegation" //nolint:gosec
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper,
+	bk types.BankKeeper, sk stakingkeeper.Keeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgCreateValidator           int
+		weightMsgDelegate                  int
+		weightMsgUndelegate                int
+		weightMsgBeginRedelegate           int
+		weightMsgCancelUnbondingDelegation int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateValidator, &weightMsgCreateValidator, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateValidator = simappparams.DefaultWeightMsgCreateValidator
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgDelegate, &weightMsgDelegate, nil,
+		func(_ *rand.Rand) {
+			weightMsgDelegate = simappparams.DefaultWeightMsgDelegate
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgUndelegate, &weightMsgUndelegate, nil,
+		func(_ *rand.Rand) {
+			weightMsgUndelegate = simappparams.DefaultWeightMsgUndelegate
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgBeginRedelegate, &weightMsgBeginRedelegate, nil,
+		func(_ *rand.Rand) {
+			weightMsgBeginRedelegate = simappparams.DefaultWeightMsgBeginRedelegate
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCancelUnbondingDelegation, &weightMsgCancelUnbondingDelegation, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelUnbondingDelegation = simappparams.DefaultWeightMsgCancelUnbondingDelegation
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateValidator,
+			SimulateMsgCreateValidator(ak, bk, sk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgDelegate,
+			SimulateMsgDelegate(ak, bk, sk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUndelegate,
+			SimulateMsgUndelegate(ak, bk, sk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgBeginRedelegate,
+			SimulateMsgBeginRedelegate(ak, bk, sk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCancelUnbondingDelegation,
+			SimulateMsgCancelUnbondingDelegation(ak, bk, sk, k),
+		),
+	}
+}
+
+// SimulateMsgCreateValidator generates a MsgCreateValidator pinned to a random bond denom
+func SimulateMsgCreateValidator(ak types.AccountKeeper, bk types.BankKeeper, sk stakingkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		address := sdk.ValAddress(simAccount.Address)
+
+		// ensure the validator doesn't exist already
+		if _, found := sk.GetValidator(ctx, address); found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateValidator, "validator already exists"), nil, nil
+		}
+
+		denom, ok := randomBondDenom(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateValidator, "no bond denom"), nil, nil
+		}
+
+		balance := bk.GetBalance(ctx, simAccount.Address, denom).Amount
+		if !balance.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateValidator, "balance is negative"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, balance)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateValidator, "unable to generate positive amount"), nil, err
+		}
+
+		selfDelegation := sdk.NewCoin(denom, amount)
+
+		description := stakingtypes.NewDescription(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 10),
+		)
+
+		maxCommission := sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 0, 100)), 2)
+		commission := stakingtypes.NewCommissionRates(
+			simtypes.RandomDecAmount(r, maxCommission),
+			maxCommission,
+			simtypes.RandomDecAmount(r, maxCommission),
+		)
+
+		msg, err := types.NewMsgCreateValidator(
+			address, simAccount.ConsKey.PubKey(), selfDelegation, description, commission, sdk.OneInt(), denom,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateValidator, "unable to create CreateValidator message"), nil, err
+		}
+
+		if reason, ok := checkMsg(ctx, k, msg, func(goCtx context.Context, msgServer types.MsgServer) error {
+			_, err := msgServer.CreateValidator(goCtx, msg)
+			return err
+		}); !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), reason), nil, nil
+		}
+
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins(selfDelegation))
+	}
+}
+
+// SimulateMsgDelegate generates a MsgDelegate of random bond tokens to a random validator
+func SimulateMsgDelegate(ak types.AccountKeeper, bk types.BankKeeper, sk stakingkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		valAddr, denom, ok := randomValidatorBondDenom(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDelegate, "no validator with a bond denom"), nil, nil
+		}
+
+		validator, found := sk.GetValidator(ctx, valAddr)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDelegate, "validator not found"), nil, nil
+		}
+		if validator.InvalidExRate() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDelegate, "validator's invalid exchange rate"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		balance := bk.GetBalance(ctx, simAccount.Address, denom).Amount
+		if !balance.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDelegate, "balance is negative"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, balance)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDelegate, "unable to generate positive amount"), nil, err
+		}
+
+		bondAmt := sdk.NewCoin(denom, amount)
+		msg := types.NewMsgDelegate(simAccount.Address, valAddr, bondAmt)
+
+		if reason, ok := checkMsg(ctx, k, msg, func(goCtx context.Context, msgServer types.MsgServer) error {
+			_, err := msgServer.Delegate(goCtx, msg)
+			return err
+		}); !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), reason), nil, nil
+		}
+
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins(bondAmt))
+	}
+}
+
+// SimulateMsgUndelegate generates a MsgUndelegate of random bond tokens of a random DV pair
+func SimulateMsgUndelegate(ak types.AccountKeeper, bk types.BankKeeper, sk stakingkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		delAddr, valAddr, bondTokens, ok := randomDVPair(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUndelegate, "no multi-staking delegation"), nil, nil
+		}
+
+		if sk.HasMaxUnbondingDelegationEntries(ctx, types.IntermediaryAccount(delAddr, valAddr), valAddr) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUndelegate, "keeper does have a max unbonding delegation entries"), nil, nil
+		}
+
+		simAccount, found := simtypes.FindAccount(accs, delAddr)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUndelegate, "delegator is not a simulation account"), nil, nil
+		}
+
+		unbondAmt, err := simtypes.RandPositiveInt(r, bondTokens.Amount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUndelegate, "invalid unbond amount"), nil, err
+		}
+
+		msg := types.NewMsgUndelegate(delAddr, valAddr, sdk.NewCoin(bondTokens.Denom, unbondAmt))
+
+		if reason, ok := checkMsg(ctx, k, msg, func(goCtx context.Context, msgServer types.MsgServer) error {
+			_, err := msgServer.Undelegate(goCtx, msg)
+			return err
+		}); !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), reason), nil, nil
+		}
+
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg, nil)
+	}
+}
+
+// SimulateMsgBeginRedelegate generates a MsgBeginRedelegate of random bond
+// tokens of a random DV pair to a random validator, converting them if the
+// destination validator is pinned to another bond denom
+func SimulateMsgBeginRedelegate(ak types.AccountKeeper, bk types.BankKeeper, sk stakingkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		delAddr, srcAddr, bondTokens, ok := randomDVPair(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, "no multi-staking delegation"), nil, nil
+		}
+
+		if sk.HasReceivingRedelegation(ctx, types.IntermediaryAccount(delAddr, srcAddr), srcAddr) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, "receiving redelegation is not allowed"), nil, nil
+		}
+
+		destAddr, destDenom, ok := randomValidatorBondDenom(r, ctx, k)
+		if !ok || srcAddr.Equals(destAddr) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, "checks failed"), nil, nil
+		}
+
+		destVal, found := sk.GetValidator(ctx, destAddr)
+		if !found || destVal.InvalidExRate() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, "checks failed"), nil, nil
+		}
+
+		simAccount, found := simtypes.FindAccount(accs, delAddr)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, "delegator is not a simulation account"), nil, nil
+		}
+
+		redAmt, err := simtypes.RandPositiveInt(r, bondTokens.Amount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, "unable to generate positive amount"), nil, err
+		}
+
+		msg := types.NewMsgBeginRedelegate(
+			delAddr, srcAddr, destAddr, sdk.NewCoin(bondTokens.Denom, redAmt), destDenom != bondTokens.Denom,
+		)
+
+		if reason, ok := checkMsg(ctx, k, msg, func(goCtx context.Context, msgServer types.MsgServer) error {
+			_, err := msgServer.BeginRedelegate(goCtx, msg)
+			return err
+		}); !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), reason), nil, nil
+		}
+
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg, nil)
+	}
+}
+
+// SimulateMsgCancelUnbondingDelegation generates a MsgCancelUnbondingDelegation
+// of random bond tokens of a random unbonding of a DV pair
+func SimulateMsgCancelUnbondingDelegation(ak types.AccountKeeper, bk types.BankKeeper, sk stakingkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		type unbonding struct {
+			delAddr        sdk.AccAddress
+			valAddr        sdk.ValAddress
+			completionTime time.Time
+			bondTokens     sdk.Coin
+		}
+		var unbondings []unbonding
+		k.IterateDVPairUnbondingTokens(ctx, func(
+			delAddr sdk.AccAddress, valAddr sdk.ValAddress, completionTime time.Time, tokens types.UnbondingTokens,
+		) bool {
+			// the unbonding tokens of a sdkbond reweighting have no bond tokens
+			if tokens.BondTokens.IsPositive() && !completionTime.Before(ctx.BlockTime()) {
+				unbondings = append(unbondings, unbonding{delAddr, valAddr, completionTime, tokens.BondTokens})
+			}
+			return false
+		})
+		if len(unbondings) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbond, "no multi-staking unbonding"), nil, nil
+		}
+		u := unbondings[r.Intn(len(unbondings))]
+
+		ubd, found := sk.GetUnbondingDelegation(ctx, types.IntermediaryAccount(u.delAddr, u.valAddr), u.valAddr)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbond, "account does have any unbonding delegation"), nil, nil
+		}
+
+		creationHeight := int64(-1)
+		for _, entry := range ubd.Entries {
+			if entry.CompletionTime.Equal(u.completionTime) {
+				creationHeight = entry.CreationHeight
+				break
+			}
+		}
+		if creationHeight < 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbond, "unbonding delegation entry not found"), nil, nil
+		}
+
+		simAccount, found := simtypes.FindAccount(accs, u.delAddr)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbond, "delegator is not a simulation account"), nil, nil
+		}
+
+		cancelAmt, err := simtypes.RandPositiveInt(r, u.bondTokens.Amount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbond, "unable to generate positive amount"), nil, err
+		}
+
+		msg := types.NewMsgCancelUnbondingDelegation(u.delAddr, u.valAddr, creationHeight, sdk.NewCoin(u.bondTokens.Denom, cancelAmt))
+
+		if reason, ok := checkMsg(ctx, k, msg, func(goCtx context.Context, msgServer types.MsgServer) error {
+			_, err := msgServer.CancelUnbondingDelegation(goCtx, msg)
+			return err
+		}); !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), reason), nil, nil
+		}
+
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg, nil)
+	}
+}
+
+// checkMsg runs a msg against a cached context and returns the reason it fails,
+// if any. The multi-staking keeper rejects amounts which are worth no sdkbond
+// tokens after the rounding, or which the staking module rejects for the
+// intermediary account, so the operations skip those msgs instead of delivering
+// failing txs.
+func checkMsg(
+	ctx sdk.Context, k keeper.Keeper, msg sdk.Msg, handle func(goCtx context.Context, msgServer types.MsgServer) error,
+) (string, bool) {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Error(), false
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	if err := handle(sdk.WrapSDKContext(cacheCtx), keeper.NewMsgServerImpl(k)); err != nil {
+		return err.Error(), false
+	}
+
+	return "", true
+}
+
+// deliverTx delivers a msg of a simulation account with random fees taken from
+// its spendable coins left after the coins spent in the msg
+func deliverTx(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper,
+	simAccount simtypes.Account, msg legacytx.LegacyMsg, coinsSpentInMsg sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+		Cdc:             nil,
+		Msg:             msg,
+		MsgType:         msg.Type(),
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+		CoinsSpentInMsg: coinsSpentInMsg,
+	}
+
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
+
+// randomBondDenom returns a random bond denom which is not sunsetting
+func randomBondDenom(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (string, bool) {
+	var denoms []string
+	k.IterateBondTokenWeights(ctx, func(denom string, _ sdk.Dec) bool {
+		if !k.IsBondDenomSunsetting(ctx, denom) {
+			denoms = append(denoms, denom)
+		}
+		return false
+	})
+	if len(denoms) == 0 {
+		return "", false
+	}
+	return denoms[r.Intn(len(denoms))], true
+}
+
+// randomValidatorBondDenom returns a random validator pinned to a bond denom
+// which is not sunsetting, along with the bond denom
+func randomValidatorBondDenom(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (sdk.ValAddress, string, bool) {
+	var (
+		valAddrs []sdk.ValAddress
+		denoms   []string
+	)
+	k.IterateValidatorBondDenoms(ctx, func(valAddr sdk.ValAddress, denom string) bool {
+		if !k.IsBondDenomSunsetting(ctx, denom) {
+			valAddrs = append(valAddrs, valAddr)
+			denoms = append(denoms, denom)
+		}
+		return false
+	})
+	if len(valAddrs) == 0 {
+		return nil, "", false
+	}
+	i := r.Intn(len(valAddrs))
+	return valAddrs[i], denoms[i], true
+}
+
+// randomDVPair returns a random DV pair with bond tokens, along with its bond tokens
+func randomDVPair(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (sdk.AccAddress, sdk.ValAddress, sdk.Coin, bool) {
+	var (
+		delAddrs   []sdk.AccAddress
+		valAddrs   []sdk.ValAddress
+		bondTokens []sdk.Coin
+	)
+	k.IterateDVPairBondTokens(ctx, func(delAddr sdk.AccAddress, valAddr sdk.ValAddress, tokens sdk.Coin) bool {
+		delAddrs = append(delAddrs, delAddr)
+		valAddrs = append(valAddrs, valAddr)
+		bondTokens = append(bondTokens, tokens)
+		return false
+	})
+	if len(delAddrs) == 0 {
+		return nil, nil, sdk.Coin{}, false
+	}
+	i := r.Intn(len(delAddrs))
+	return delAddrs[i], valAddrs[i], bondTokens[i], true
+}
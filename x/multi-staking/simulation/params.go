@@ -0,0 +1,40 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyReweightingBatchSize),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenReweightingBatchSize(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyWeightEpochLength),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenWeightEpochLength(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeySunsetBatchSize),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenSunsetBatchSize(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeySDKBondTransferable),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%t", GenSDKBondTransferable(r))
+			},
+		),
+	}
+}
@@ -0,0 +1,49 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/keeper"
+	"github.com/notional-labs/multi-staking-module/x/multi-staking/types"
+)
+
+const (
+	// OpWeightSubmitChangeBondTokenWeightProposal app params key for change bond token weight proposal
+	OpWeightSubmitChangeBondTokenWeightProposal = "op_weight_submit_change_bond_token_weight_proposal" //nolint:gosec
+
+	// DefaultWeightChangeBondTokenWeightProposal is the default weight of the change bond token weight proposal
+	DefaultWeightChangeBondTokenWeightProposal int = 5
+)
+
+// ProposalContents defines the module weighted proposals' contents
+func ProposalContents(k keeper.Keeper) []simtypes.WeightedProposalContent {
+	return []simtypes.WeightedProposalContent{
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitChangeBondTokenWeightProposal,
+			DefaultWeightChangeBondTokenWeightProposal,
+			SimulateChangeBondTokenWeightProposalContent(k),
+		),
+	}
+}
+
+// SimulateChangeBondTokenWeightProposalContent generates random change bond
+// token weight proposal content for a bond denom which is not sunsetting
+func SimulateChangeBondTokenWeightProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) simtypes.Content {
+		denom, ok := randomBondDenom(r, ctx, k)
+		if !ok {
+			return nil
+		}
+
+		return types.NewChangeBondTokenWeightProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			denom,
+			GenBondTokenWeight(r),
+		)
+	}
+}
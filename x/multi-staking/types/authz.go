@@ -0,0 +1,163 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// gasCostPerIteration is the gas charged per validator of the allow or deny
+// list checked, as charged by the sdk StakeAuthorization.
+const gasCostPerIteration = uint64(10)
+
+var _ authz.Authorization = &MultiStakeAuthorization{}
+
+// NewMultiStakeAuthorization creates a new MultiStakeAuthorization. Either the
+// allowed or the denied validators must be given. The max tokens limit the
+// bond denoms and amounts the grantee may use, no max tokens allow any.
+// Converting redelegations are only allowed with allowConvert.
+func NewMultiStakeAuthorization(
+	allowed, denied []sdk.ValAddress, authzType stakingtypes.AuthorizationType, maxTokens sdk.Coins, allowConvert bool,
+) (*MultiStakeAuthorization, error) {
+	if len(allowed) == 0 && len(denied) == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("both allowed & deny list cannot be empty")
+	}
+	if len(allowed) > 0 && len(denied) > 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("cannot set both allowed & deny list")
+	}
+
+	a := MultiStakeAuthorization{
+		MaxTokens:         maxTokens,
+		AuthorizationType: authzType,
+		AllowConvert:      allowConvert,
+	}
+	if len(allowed) > 0 {
+		a.Validators = &MultiStakeAuthorization_AllowList{AllowList: &MultiStakeAuthorization_Validators{Address: valAddressStrings(allowed)}}
+	} else {
+		a.Validators = &MultiStakeAuthorization_DenyList{DenyList: &MultiStakeAuthorization_Validators{Address: valAddressStrings(denied)}}
+	}
+
+	return &a, nil
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a MultiStakeAuthorization) MsgTypeURL() string {
+	switch a.AuthorizationType {
+	case stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE:
+		return sdk.MsgTypeURL(&MsgDelegate{})
+	case stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_UNDELEGATE:
+		return sdk.MsgTypeURL(&MsgUndelegate{})
+	case stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE:
+		return sdk.MsgTypeURL(&MsgBeginRedelegate{})
+	default:
+		panic(sdkerrors.Wrapf(authz.ErrUnknownAuthorizationType, "cannot normalize authz type with %T", a.AuthorizationType))
+	}
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a MultiStakeAuthorization) ValidateBasic() error {
+	if !a.MaxTokens.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid max tokens: %s", a.MaxTokens)
+	}
+	if len(a.GetAllowList().GetAddress()) == 0 && len(a.GetDenyList().GetAddress()) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("both allowed & deny list cannot be empty")
+	}
+	for _, addr := range append(a.GetAllowList().GetAddress(), a.GetDenyList().GetAddress()...) {
+		if _, err := sdk.ValAddressFromBech32(addr); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address %s: %s", addr, err)
+		}
+	}
+
+	switch a.AuthorizationType {
+	case stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
+		stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_UNDELEGATE,
+		stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE:
+		return nil
+	default:
+		return authz.ErrUnknownAuthorizationType
+	}
+}
+
+// Accept implements Authorization.Accept. The validator, the destination one
+// for a redelegation, must be allowed, a redelegation may only convert the bond
+// tokens if AllowConvert is set, and the bond tokens must be within the max
+// tokens of their denom, which are reduced by them.
+func (a MultiStakeAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	var (
+		validatorAddress string
+		amount           sdk.Coin
+	)
+
+	switch msg := msg.(type) {
+	case *MsgDelegate:
+		validatorAddress = msg.ValidatorAddress
+		amount = msg.Amount
+	case *MsgUndelegate:
+		validatorAddress = msg.ValidatorAddress
+		amount = msg.Amount
+	case *MsgBeginRedelegate:
+		if msg.Convert && !a.AllowConvert {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("cannot convert bond tokens")
+		}
+		validatorAddress = msg.ValidatorDstAddress
+		amount = msg.Amount
+	default:
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidRequest.Wrap("unknown msg type")
+	}
+
+	isValidatorAllowed := false
+	allowList := a.GetAllowList().GetAddress()
+	for _, validator := range allowList {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "multi-stake authorization")
+		if validator == validatorAddress {
+			isValidatorAllowed = true
+			break
+		}
+	}
+
+	for _, validator := range a.GetDenyList().GetAddress() {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "multi-stake authorization")
+		if validator == validatorAddress {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot delegate/undelegate to %s validator", validator)
+		}
+	}
+
+	if len(allowList) > 0 && !isValidatorAllowed {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot delegate/undelegate to %s validator", validatorAddress)
+	}
+
+	if a.MaxTokens.Empty() {
+		return authz.AcceptResponse{Accept: true}, nil
+	}
+
+	found, maxTokens := a.MaxTokens.Find(amount.Denom)
+	if !found {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot delegate/undelegate %s bond tokens", amount.Denom)
+	}
+	if maxTokens.IsLT(amount) {
+		return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("%s is more than the max tokens %s", amount, maxTokens)
+	}
+
+	limitLeft := a.MaxTokens.Sub(amount)
+	if limitLeft.IsZero() {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+	return authz.AcceptResponse{
+		Accept: true,
+		Updated: &MultiStakeAuthorization{
+			MaxTokens:         limitLeft,
+			Validators:        a.GetValidators(),
+			AuthorizationType: a.GetAuthorizationType(),
+			AllowConvert:      a.GetAllowConvert(),
+		},
+	}, nil
+}
+
+func valAddressStrings(valAddrs []sdk.ValAddress) []string {
+	addrs := make([]string, len(valAddrs))
+	for i, valAddr := range valAddrs {
+		addrs[i] = valAddr.String()
+	}
+	return addrs
+}
@@ -0,0 +1,811 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: multistaking/v1/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/x/staking/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MultiStakeAuthorization is an authz Authorization to delegate, undelegate or
+// redelegate bond tokens through the multi-staking module on behalf of the
+// granter.
+type MultiStakeAuthorization struct {
+	// max_tokens are the bond tokens the grantee may still delegate, undelegate
+	// or redelegate, one coin per bond denom. Only the listed bond denoms may be
+	// used. If it is empty, any bond denom and amount may be used.
+	MaxTokens github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=max_tokens,json=maxTokens,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_tokens"`
+	// validators is either the validators the grantee may delegate to, or the
+	// validators it may not delegate to. Redelegations are checked against their
+	// destination validator.
+	//
+	// Types that are valid to be assigned to Validators:
+	//	*MultiStakeAuthorization_AllowList
+	//	*MultiStakeAuthorization_DenyList
+	Validators isMultiStakeAuthorization_Validators `protobuf_oneof:"validators"`
+	// authorization_type is the multi-staking message the grantee may execute.
+	AuthorizationType types1.AuthorizationType `protobuf:"varint,4,opt,name=authorization_type,json=authorizationType,proto3,enum=cosmos.staking.v1beta1.AuthorizationType" json:"authorization_type,omitempty"`
+	// allow_convert lets the grantee execute redelegations converting the bond
+	// tokens to the bond denom of the destination validator.
+	AllowConvert bool `protobuf:"varint,5,opt,name=allow_convert,json=allowConvert,proto3" json:"allow_convert,omitempty"`
+}
+
+func (m *MultiStakeAuthorization) Reset()         { *m = MultiStakeAuthorization{} }
+func (m *MultiStakeAuthorization) String() string { return proto.CompactTextString(m) }
+func (*MultiStakeAuthorization) ProtoMessage()    {}
+func (*MultiStakeAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed030c9887bda19e, []int{0}
+}
+func (m *MultiStakeAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiStakeAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiStakeAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiStakeAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiStakeAuthorization.Merge(m, src)
+}
+func (m *MultiStakeAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiStakeAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiStakeAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiStakeAuthorization proto.InternalMessageInfo
+
+type isMultiStakeAuthorization_Validators interface {
+	isMultiStakeAuthorization_Validators()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type MultiStakeAuthorization_AllowList struct {
+	AllowList *MultiStakeAuthorization_Validators `protobuf:"bytes,2,opt,name=allow_list,json=allowList,proto3,oneof" json:"allow_list,omitempty"`
+}
+type MultiStakeAuthorization_DenyList struct {
+	DenyList *MultiStakeAuthorization_Validators `protobuf:"bytes,3,opt,name=deny_list,json=denyList,proto3,oneof" json:"deny_list,omitempty"`
+}
+
+func (*MultiStakeAuthorization_AllowList) isMultiStakeAuthorization_Validators() {}
+func (*MultiStakeAuthorization_DenyList) isMultiStakeAuthorization_Validators()  {}
+
+func (m *MultiStakeAuthorization) GetValidators() isMultiStakeAuthorization_Validators {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *MultiStakeAuthorization) GetMaxTokens() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxTokens
+	}
+	return nil
+}
+
+func (m *MultiStakeAuthorization) GetAllowList() *MultiStakeAuthorization_Validators {
+	if x, ok := m.GetValidators().(*MultiStakeAuthorization_AllowList); ok {
+		return x.AllowList
+	}
+	return nil
+}
+
+func (m *MultiStakeAuthorization) GetDenyList() *MultiStakeAuthorization_Validators {
+	if x, ok := m.GetValidators().(*MultiStakeAuthorization_DenyList); ok {
+		return x.DenyList
+	}
+	return nil
+}
+
+func (m *MultiStakeAuthorization) GetAuthorizationType() types1.AuthorizationType {
+	if m != nil {
+		return m.AuthorizationType
+	}
+	return types1.AuthorizationType_AUTHORIZATION_TYPE_UNSPECIFIED
+}
+
+func (m *MultiStakeAuthorization) GetAllowConvert() bool {
+	if m != nil {
+		return m.AllowConvert
+	}
+	return false
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*MultiStakeAuthorization) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*MultiStakeAuthorization_AllowList)(nil),
+		(*MultiStakeAuthorization_DenyList)(nil),
+	}
+}
+
+// Validators is a list of validator addresses.
+type MultiStakeAuthorization_Validators struct {
+	Address []string `protobuf:"bytes,1,rep,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *MultiStakeAuthorization_Validators) Reset()         { *m = MultiStakeAuthorization_Validators{} }
+func (m *MultiStakeAuthorization_Validators) String() string { return proto.CompactTextString(m) }
+func (*MultiStakeAuthorization_Validators) ProtoMessage()    {}
+func (*MultiStakeAuthorization_Validators) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed030c9887bda19e, []int{0, 0}
+}
+func (m *MultiStakeAuthorization_Validators) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiStakeAuthorization_Validators) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiStakeAuthorization_Validators.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiStakeAuthorization_Validators) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiStakeAuthorization_Validators.Merge(m, src)
+}
+func (m *MultiStakeAuthorization_Validators) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiStakeAuthorization_Validators) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiStakeAuthorization_Validators.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiStakeAuthorization_Validators proto.InternalMessageInfo
+
+func (m *MultiStakeAuthorization_Validators) GetAddress() []string {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MultiStakeAuthorization)(nil), "multistaking.v1.MultiStakeAuthorization")
+	proto.RegisterType((*MultiStakeAuthorization_Validators)(nil), "multistaking.v1.MultiStakeAuthorization.Validators")
+}
+
+func init() { proto.RegisterFile("multistaking/v1/authz.proto", fileDescriptor_ed030c9887bda19e) }
+
+var fileDescriptor_ed030c9887bda19e = []byte{
+	// 467 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x13, 0x36, 0x60, 0xf5, 0x06, 0x88, 0x68, 0x12, 0x59, 0x91, 0xb2, 0x6a, 0x48, 0x28,
+	0x1c, 0x62, 0xd3, 0xee, 0xc6, 0x89, 0xb5, 0x17, 0x0e, 0x70, 0xc9, 0x2a, 0x84, 0x90, 0x50, 0xe5,
+	0x34, 0x56, 0x6a, 0x9a, 0xf8, 0xab, 0x62, 0x27, 0xb4, 0x7b, 0x0a, 0x9e, 0x82, 0x03, 0xe7, 0x3d,
+	0xc4, 0xc4, 0x69, 0xe2, 0xc4, 0x09, 0x50, 0xfb, 0x22, 0x28, 0xb1, 0x47, 0xb3, 0x0a, 0x4e, 0x3b,
+	0x25, 0xfe, 0xfe, 0x9f, 0xff, 0xfe, 0xf9, 0xef, 0x0f, 0x3d, 0xce, 0x8a, 0x54, 0x71, 0xa9, 0xe8,
+	0x94, 0x8b, 0x84, 0x94, 0x5d, 0x42, 0x0b, 0x35, 0x39, 0xc3, 0xb3, 0x1c, 0x14, 0x38, 0x0f, 0x9a,
+	0x22, 0x2e, 0xbb, 0xed, 0xfd, 0x04, 0x12, 0xa8, 0x35, 0x52, 0xfd, 0xe9, 0xb6, 0xf6, 0xc1, 0x18,
+	0x64, 0x06, 0x72, 0xa4, 0x05, 0xbd, 0x30, 0x92, 0xa7, 0x57, 0x24, 0xa2, 0x92, 0x91, 0xb2, 0x1b,
+	0x31, 0x45, 0xbb, 0x64, 0x0c, 0x5c, 0x18, 0xfd, 0xc8, 0xe8, 0x6b, 0x00, 0xdd, 0xd2, 0xa0, 0x38,
+	0xfa, 0xb2, 0x8d, 0x1e, 0xbd, 0xa9, 0x40, 0x4e, 0x15, 0x9d, 0xb2, 0x93, 0x42, 0x4d, 0x20, 0xe7,
+	0x67, 0x54, 0x71, 0x10, 0xce, 0x47, 0x84, 0x32, 0x3a, 0x1f, 0x29, 0x98, 0x32, 0x21, 0x5d, 0xbb,
+	0xb3, 0xe5, 0xef, 0xf6, 0x0e, 0xb0, 0x41, 0xa8, 0x0e, 0xc5, 0xc6, 0x11, 0x0f, 0x80, 0x8b, 0xfe,
+	0xf3, 0x8b, 0x9f, 0x87, 0xd6, 0xd7, 0x5f, 0x87, 0x7e, 0xc2, 0xd5, 0xa4, 0x88, 0xf0, 0x18, 0x32,
+	0xc3, 0x6b, 0x3e, 0x81, 0x8c, 0xa7, 0x44, 0x2d, 0x66, 0x4c, 0xd6, 0x1b, 0x64, 0xd8, 0xca, 0xe8,
+	0x7c, 0x58, 0xbb, 0x3b, 0x43, 0x84, 0x68, 0x9a, 0xc2, 0xa7, 0x51, 0xca, 0xa5, 0x72, 0x6f, 0x75,
+	0x6c, 0x7f, 0xb7, 0x77, 0x8c, 0x37, 0x22, 0xc2, 0xff, 0x21, 0xc5, 0x6f, 0x69, 0xca, 0x63, 0xaa,
+	0x20, 0x97, 0xaf, 0xac, 0xb0, 0x55, 0x1b, 0xbd, 0xe6, 0x52, 0x39, 0x21, 0x6a, 0xc5, 0x4c, 0x2c,
+	0xb4, 0xe9, 0xd6, 0x4d, 0x4c, 0x77, 0x2a, 0x9f, 0xda, 0xf3, 0x1d, 0x72, 0x68, 0xb3, 0x6f, 0x54,
+	0xdd, 0xc8, 0xdd, 0xee, 0xd8, 0xfe, 0xfd, 0xde, 0xb3, 0xab, 0x74, 0xd6, 0xf6, 0x3a, 0xa0, 0x6b,
+	0xce, 0xc3, 0xc5, 0x8c, 0x85, 0x0f, 0xe9, 0x66, 0xc9, 0x79, 0x82, 0xee, 0xe9, 0x0c, 0xc6, 0x20,
+	0x4a, 0x96, 0x2b, 0xf7, 0x76, 0xc7, 0xf6, 0x77, 0xc2, 0xbd, 0xba, 0x38, 0xd0, 0xb5, 0xf6, 0x4b,
+	0x84, 0xd6, 0x60, 0x4e, 0x0f, 0xdd, 0xa5, 0x71, 0x9c, 0x33, 0xa9, 0xdf, 0xa7, 0xd5, 0x77, 0xbf,
+	0x9f, 0x07, 0xfb, 0x06, 0xe2, 0x44, 0x2b, 0xa7, 0x2a, 0xe7, 0x22, 0x09, 0xaf, 0x1a, 0x5f, 0x3c,
+	0xfd, 0x76, 0x1e, 0x98, 0xd1, 0xc0, 0x7a, 0x14, 0xfe, 0x49, 0xd9, 0xdf, 0x43, 0xa8, 0xfc, 0x7b,
+	0x52, 0xff, 0xc3, 0xc5, 0xd2, 0xb3, 0x2f, 0x97, 0x9e, 0xfd, 0x7b, 0xe9, 0xd9, 0x9f, 0x57, 0x9e,
+	0x75, 0xb9, 0xf2, 0xac, 0x1f, 0x2b, 0xcf, 0x7a, 0x3f, 0x68, 0xbc, 0xb7, 0x80, 0x6a, 0x2b, 0x4d,
+	0x83, 0x94, 0x46, 0x92, 0xd4, 0x49, 0x07, 0x26, 0x8b, 0x20, 0x83, 0xb8, 0x48, 0x19, 0x99, 0x5f,
+	0x2f, 0xeb, 0x81, 0x88, 0xee, 0xd4, 0xe3, 0x78, 0xfc, 0x67, 0x00, 0xf1, 0x7f, 0x3c, 0xbc, 0x33,
+	0x03, 0x00, 0x00,
+}
+
+func (m *MultiStakeAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiStakeAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiStakeAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AllowConvert {
+		i--
+		if m.AllowConvert {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AuthorizationType != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.AuthorizationType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Validators != nil {
+		{
+			size := m.Validators.Size()
+			i -= size
+			if _, err := m.Validators.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.MaxTokens) > 0 {
+		for iNdEx := len(m.MaxTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultiStakeAuthorization_AllowList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiStakeAuthorization_AllowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AllowList != nil {
+		{
+			size, err := m.AllowList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *MultiStakeAuthorization_DenyList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiStakeAuthorization_DenyList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DenyList != nil {
+		{
+			size, err := m.DenyList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *MultiStakeAuthorization_Validators) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiStakeAuthorization_Validators) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiStakeAuthorization_Validators) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		for iNdEx := len(m.Address) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Address[iNdEx])
+			copy(dAtA[i:], m.Address[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.Address[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MultiStakeAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MaxTokens) > 0 {
+		for _, e := range m.MaxTokens {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.Validators != nil {
+		n += m.Validators.Size()
+	}
+	if m.AuthorizationType != 0 {
+		n += 1 + sovAuthz(uint64(m.AuthorizationType))
+	}
+	if m.AllowConvert {
+		n += 2
+	}
+	return n
+}
+
+func (m *MultiStakeAuthorization_AllowList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AllowList != nil {
+		l = m.AllowList.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+func (m *MultiStakeAuthorization_DenyList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DenyList != nil {
+		l = m.DenyList.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+func (m *MultiStakeAuthorization_Validators) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		for _, s := range m.Address {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MultiStakeAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiStakeAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiStakeAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxTokens = append(m.MaxTokens, types.Coin{})
+			if err := m.MaxTokens[len(m.MaxTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MultiStakeAuthorization_Validators{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Validators = &MultiStakeAuthorization_AllowList{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenyList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MultiStakeAuthorization_Validators{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Validators = &MultiStakeAuthorization_DenyList{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizationType", wireType)
+			}
+			m.AuthorizationType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthorizationType |= types1.AuthorizationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowConvert", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowConvert = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiStakeAuthorization_Validators) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validators: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validators: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
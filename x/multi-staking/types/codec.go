@@ -0,0 +1,91 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+// RegisterLegacyAminoCodec registers the necessary x/multi-staking interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgCreateValidator{}, "multistaking/MsgCreateValidator")
+	legacy.RegisterAminoMsg(cdc, &MsgDelegate{}, "multistaking/MsgDelegate")
+	legacy.RegisterAminoMsg(cdc, &MsgUndelegate{}, "multistaking/MsgUndelegate")
+	legacy.RegisterAminoMsg(cdc, &MsgBeginRedelegate{}, "multistaking/MsgBeginRedelegate")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelUnbondingDelegation{}, "multistaking/MsgCancelUnbonding")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawDelegatorReward{}, "multistaking/MsgWithdrawDelegatorReward")
+	legacy.RegisterAminoMsg(cdc, &MsgVote{}, "multistaking/MsgVote")
+	legacy.RegisterAminoMsg(cdc, &MsgVoteWeighted{}, "multistaking/MsgVoteWeighted")
+	legacy.RegisterAminoMsg(cdc, &MsgUnjail{}, "multistaking/MsgUnjail")
+	legacy.RegisterAminoMsg(cdc, &MsgFundConversionReserve{}, "multistaking/MsgFundConversionReserve")
+
+	cdc.RegisterConcrete(&AddBondDenomProposal{}, "multistaking/AddBondDenomProposal", nil)
+	cdc.RegisterConcrete(&ChangeBondTokenWeightProposal{}, "multistaking/ChangeBondTokenWeightProposal", nil)
+	cdc.RegisterConcrete(&RemoveBondTokenProposal{}, "multistaking/RemoveBondTokenProposal", nil)
+	cdc.RegisterConcrete(&SetStakingCapsProposal{}, "multistaking/SetStakingCapsProposal", nil)
+	cdc.RegisterConcrete(&SetWeightBoundsProposal{}, "multistaking/SetWeightBoundsProposal", nil)
+	cdc.RegisterConcrete(&ConversionReserveSpendProposal{}, "multistaking/ConversionReserveSpendProposal", nil)
+
+	cdc.RegisterInterface((*isMultiStakeAuthorization_Validators)(nil), nil)
+	cdc.RegisterConcrete(&MultiStakeAuthorization_AllowList{}, "multistaking/MultiStakeAuthorization/AllowList", nil)
+	cdc.RegisterConcrete(&MultiStakeAuthorization_DenyList{}, "multistaking/MultiStakeAuthorization/DenyList", nil)
+	cdc.RegisterConcrete(&MultiStakeAuthorization{}, "multistaking/MultiStakeAuthorization", nil)
+}
+
+// RegisterInterfaces registers the x/multi-staking interfaces types with the interface registry
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateValidator{},
+		&MsgDelegate{},
+		&MsgUndelegate{},
+		&MsgBeginRedelegate{},
+		&MsgCancelUnbondingDelegation{},
+		&MsgWithdrawDelegatorReward{},
+		&MsgVote{},
+		&MsgVoteWeighted{},
+		&MsgUnjail{},
+		&MsgFundConversionReserve{},
+	)
+
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&AddBondDenomProposal{},
+		&ChangeBondTokenWeightProposal{},
+		&RemoveBondTokenProposal{},
+		&SetStakingCapsProposal{},
+		&SetWeightBoundsProposal{},
+		&ConversionReserveSpendProposal{},
+	)
+
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&MultiStakeAuthorization{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	sdk.RegisterLegacyAminoCodec(amino)
+
+	// Register all Amino interfaces and concrete types on the authz and gov Amino codecs so that this can later be
+	// used to properly serialize MsgGrant, MsgExec and MsgSubmitProposal instances
+	RegisterLegacyAminoCodec(authzcodec.Amino)
+	RegisterLegacyAminoCodec(govtypes.ModuleCdc.LegacyAmino)
+	RegisterLegacyAminoCodec(govv1.ModuleCdc.LegacyAmino)
+}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/multi-staking module sentinel errors
+var (
+	ErrInvalidBondDenom              = sdkerrors.Register(ModuleName, 2, "denom is not a bond token")
+	ErrValidatorBondDenomNotFound    = sdkerrors.Register(ModuleName, 3, "validator bond denom not found")
+	ErrMismatchedValidatorBondDenom  = sdkerrors.Register(ModuleName, 4, "denom does not match the validator bond denom")
+	ErrInvalidBondTokenWeight        = sdkerrors.Register(ModuleName, 5, "invalid bond token weight")
+	ErrBondDenomAlreadyExists        = sdkerrors.Register(ModuleName, 6, "bond denom already exists")
+	ErrBondDenomSunsetting           = sdkerrors.Register(ModuleName, 7, "bond denom is being removed")
+	ErrRedelegationBondDenomMismatch = sdkerrors.Register(ModuleName, 8, "redelegation between validators of different bond denoms")
+	ErrStakingMsgNotAllowed          = sdkerrors.Register(ModuleName, 9, "sdk staking message not allowed")
+	ErrSDKBondNotTransferable        = sdkerrors.Register(ModuleName, 10, "sdkbond tokens are not transferable")
+	ErrConvertDelegatedVesting       = sdkerrors.Register(ModuleName, 11, "cannot convert delegated vesting bond tokens")
+	ErrDenomTraceNotFound            = sdkerrors.Register(ModuleName, 12, "denom trace not found")
+	ErrInvalidSourceChannel          = sdkerrors.Register(ModuleName, 13, "invalid source channel")
+	ErrInvalidStakingCaps            = sdkerrors.Register(ModuleName, 14, "invalid staking caps")
+	ErrStakingCapExceeded            = sdkerrors.Register(ModuleName, 15, "bond denom staking cap exceeded")
+	ErrInvalidWeightBounds           = sdkerrors.Register(ModuleName, 16, "invalid weight bounds")
+	ErrInsufficientConversionReserve = sdkerrors.Register(ModuleName, 17, "insufficient conversion reserve")
+)
@@ -0,0 +1,40 @@
+package types
+
+// multi-staking module event types
+const (
+	EventTypeCreateValidator      = "create_validator"
+	EventTypeDelegate             = "delegate"
+	EventTypeUnbond               = "unbond"
+	EventTypeCompleteUnbonding    = "complete_unbonding"
+	EventTypeRedelegate           = "redelegate"
+	EventTypeCancelUnbonding      = "cancel_unbonding_delegation"
+	EventTypeCompleteRedelegation = "complete_redelegation"
+	EventTypeSunsetBondDenom      = "sunset_bond_denom"
+	EventTypeForceUnbond          = "force_unbond"
+	EventTypeRemoveBondDenom      = "remove_bond_denom"
+	EventTypeReweight             = "reweight"
+	EventTypeUpdateWeight         = "update_bond_token_weight"
+	EventTypeWithdrawRewards      = "withdraw_rewards"
+	EventTypeVote                 = "vote"
+
+	AttributeKeyValidator       = "validator"
+	AttributeKeyDelegator       = "delegator"
+	AttributeKeyNewShares       = "new_shares"
+	AttributeKeyBondDenom       = "bond_denom"
+	AttributeKeyCompletionTime  = "completion_time"
+	AttributeKeyCreationHeight  = "creation_height"
+	AttributeKeySunsetHeight    = "sunset_height"
+	AttributeKeyOldSDKBond      = "old_sdk_bond_amount"
+	AttributeKeyNewSDKBond      = "new_sdk_bond_amount"
+	AttributeKeyVoter           = "voter"
+	AttributeKeySrcValidator    = "source_validator"
+	AttributeKeyDstValidator    = "destination_validator"
+	AttributeKeySrcBondDenom    = "source_bond_denom"
+	AttributeKeyDstBondDenom    = "destination_bond_denom"
+	AttributeKeyConvertedAmount = "converted_amount"
+	AttributeKeyProposalID      = "proposal_id"
+	AttributeKeyOption          = "option"
+	AttributeKeyOldWeight       = "old_weight"
+	AttributeKeyNewWeight       = "new_weight"
+	AttributeValueCategory      = ModuleName
+)
@@ -0,0 +1,262 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new GenesisState instance
+func NewGenesisState(params Params, bondTokenWeights []BondTokenWeight, validatorBondDenoms []ValidatorBondDenom) *GenesisState {
+	return &GenesisState{
+		Params:              params,
+		BondTokenWeights:    bondTokenWeights,
+		ValidatorBondDenoms: validatorBondDenoms,
+		IssuedSDKBondTokens: sdk.ZeroInt(),
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the multi-staking module
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:              DefaultParams(),
+		IssuedSDKBondTokens: sdk.ZeroInt(),
+	}
+}
+
+// ValidateSDKBondDenom checks that the sdkbond denom, the bond denom of the
+// staking module, is not a bond denom, since its tokens cannot back themselves.
+func (gs GenesisState) ValidateSDKBondDenom(sdkBondDenom string) error {
+	for _, weight := range gs.BondTokenWeights {
+		if weight.BondDenom == sdkBondDenom {
+			return fmt.Errorf("the sdkbond denom %s cannot be a bond denom", sdkBondDenom)
+		}
+	}
+	return nil
+}
+
+// Validate performs basic genesis state validation, returning an error upon any failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	weights := make(map[string]bool)
+	for _, weight := range gs.BondTokenWeights {
+		if err := sdk.ValidateDenom(weight.BondDenom); err != nil {
+			return err
+		}
+		if weights[weight.BondDenom] {
+			return fmt.Errorf("duplicate bond token weight for %s", weight.BondDenom)
+		}
+		if err := validateBondTokenWeight(weight.BondTokenWeight); err != nil {
+			return err
+		}
+		weights[weight.BondDenom] = true
+	}
+
+	validatorBondDenoms := make(map[string]string)
+	for _, v := range gs.ValidatorBondDenoms {
+		if _, err := sdk.ValAddressFromBech32(v.ValidatorAddress); err != nil {
+			return err
+		}
+		if _, found := validatorBondDenoms[v.ValidatorAddress]; found {
+			return fmt.Errorf("duplicate bond denom for validator %s", v.ValidatorAddress)
+		}
+		if !weights[v.BondDenom] {
+			return fmt.Errorf("validator %s is pinned to %s which is not a bond denom", v.ValidatorAddress, v.BondDenom)
+		}
+		validatorBondDenoms[v.ValidatorAddress] = v.BondDenom
+	}
+
+	intermediaryAccounts := make(map[string]string)
+	for _, i := range gs.IntermediaryAccountDelegators {
+		if _, err := sdk.AccAddressFromBech32(i.IntermediaryAddress); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(i.DelegatorAddress); err != nil {
+			return err
+		}
+		if _, found := intermediaryAccounts[i.IntermediaryAddress]; found {
+			return fmt.Errorf("duplicate delegator for intermediary account %s", i.IntermediaryAddress)
+		}
+		intermediaryAccounts[i.IntermediaryAddress] = i.DelegatorAddress
+	}
+
+	// validateDVPair checks the addresses of a DV pair and that its
+	// intermediary account acts for its delegator
+	validateDVPair := func(delegator, validator string) (string, error) {
+		delAddr, err := sdk.AccAddressFromBech32(delegator)
+		if err != nil {
+			return "", err
+		}
+		valAddr, err := sdk.ValAddressFromBech32(validator)
+		if err != nil {
+			return "", err
+		}
+		if intermediaryAccounts[IntermediaryAccount(delAddr, valAddr).String()] != delegator {
+			return "", fmt.Errorf("no intermediary account of DV pair (%s, %s)", delegator, validator)
+		}
+		return string(GetDVPairKey(delAddr, valAddr)), nil
+	}
+
+	// validateBondTokens checks that bond tokens are of the bond denom of
+	// their validator
+	validateBondTokens := func(validator string, bondTokens sdk.Coin) error {
+		if err := bondTokens.Validate(); err != nil {
+			return err
+		}
+		if bondDenom := validatorBondDenoms[validator]; bondDenom != bondTokens.Denom {
+			return fmt.Errorf("bond tokens %s do not match the bond denom %q of validator %s", bondTokens, bondDenom, validator)
+		}
+		return nil
+	}
+
+	bondTokens := make(map[string]bool)
+	for _, b := range gs.DVPairBondTokens {
+		key, err := validateDVPair(b.DelegatorAddress, b.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+		if bondTokens[key] {
+			return fmt.Errorf("duplicate bond tokens for DV pair (%s, %s)", b.DelegatorAddress, b.ValidatorAddress)
+		}
+		if err := validateBondTokens(b.ValidatorAddress, b.BondTokens); err != nil {
+			return err
+		}
+		if !b.BondTokens.IsPositive() {
+			return fmt.Errorf("bond tokens of DV pair (%s, %s) must be positive", b.DelegatorAddress, b.ValidatorAddress)
+		}
+		bondTokens[key] = true
+	}
+
+	issued := sdk.ZeroInt()
+	sdkBondTokens := make(map[string]bool)
+	for _, s := range gs.DVPairSDKBondTokens {
+		key, err := validateDVPair(s.DelegatorAddress, s.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+		if sdkBondTokens[key] {
+			return fmt.Errorf("duplicate sdkbond tokens for DV pair (%s, %s)", s.DelegatorAddress, s.ValidatorAddress)
+		}
+		if !bondTokens[key] {
+			return fmt.Errorf("sdkbond tokens of DV pair (%s, %s) without bond tokens", s.DelegatorAddress, s.ValidatorAddress)
+		}
+		if err := s.SDKBondTokens.Validate(); err != nil {
+			return err
+		}
+		if !s.SDKBondTokens.IsPositive() {
+			return fmt.Errorf("sdkbond tokens of DV pair (%s, %s) must be positive", s.DelegatorAddress, s.ValidatorAddress)
+		}
+		sdkBondTokens[key] = true
+		issued = issued.Add(s.SDKBondTokens.Amount)
+	}
+
+	unbondingTokens := make(map[string]bool)
+	for _, u := range gs.DVPairUnbondingTokens {
+		key, err := validateDVPair(u.DelegatorAddress, u.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+		key += u.CompletionTime.UTC().Format(time.RFC3339Nano)
+		if unbondingTokens[key] {
+			return fmt.Errorf("duplicate unbonding tokens for DV pair (%s, %s) completing at %s",
+				u.DelegatorAddress, u.ValidatorAddress, u.CompletionTime)
+		}
+		if err := validateBondTokens(u.ValidatorAddress, u.UnbondingTokens.BondTokens); err != nil {
+			return err
+		}
+		if err := u.UnbondingTokens.SDKBondTokens.Validate(); err != nil {
+			return err
+		}
+		unbondingTokens[key] = true
+		issued = issued.Add(u.UnbondingTokens.SDKBondTokens.Amount)
+	}
+
+	// the sdkbond tokens issued by the module are those of the DV pairs and
+	// of their unbonding tokens
+	issuedSDKBondTokens := gs.IssuedSDKBondTokens
+	if issuedSDKBondTokens.IsNil() {
+		issuedSDKBondTokens = sdk.ZeroInt()
+	}
+	if !issuedSDKBondTokens.Equal(issued) {
+		return fmt.Errorf("issued sdkbond tokens %s do not match the sdkbond tokens of the DV pairs %s", issuedSDKBondTokens, issued)
+	}
+
+	sunsetting := make(map[string]bool)
+	for _, s := range gs.BondDenomSunsetHeights {
+		if !weights[s.BondDenom] {
+			return fmt.Errorf("sunsetting bond denom %s has no weight", s.BondDenom)
+		}
+		if sunsetting[s.BondDenom] {
+			return fmt.Errorf("duplicate sunset height for %s", s.BondDenom)
+		}
+		if s.Height < 0 {
+			return fmt.Errorf("sunset height of %s must not be negative", s.BondDenom)
+		}
+		sunsetting[s.BondDenom] = true
+	}
+
+	sunsetCursors := make(map[string]bool)
+	for _, c := range gs.SunsetCursors {
+		if !sunsetting[c.BondDenom] {
+			return fmt.Errorf("force-undelegated bond denom %s is not sunsetting", c.BondDenom)
+		}
+		if sunsetCursors[c.BondDenom] {
+			return fmt.Errorf("duplicate sunset cursor for %s", c.BondDenom)
+		}
+		if len(c.NextDvPair) == 0 {
+			return fmt.Errorf("sunset cursor of %s must not be empty", c.BondDenom)
+		}
+		sunsetCursors[c.BondDenom] = true
+	}
+
+	reweightings := make(map[string]bool)
+	for _, r := range gs.Reweightings {
+		if !weights[r.BondDenom] {
+			return fmt.Errorf("reweighted bond denom %s has no weight", r.BondDenom)
+		}
+		if reweightings[r.BondDenom] {
+			return fmt.Errorf("duplicate reweighting for %s", r.BondDenom)
+		}
+		if err := validateBondTokenWeight(r.Reweighting.BondTokenWeight); err != nil {
+			return err
+		}
+		if r.Reweighting.NextPassHeight < 0 {
+			return fmt.Errorf("next pass height of the reweighting of %s must not be negative", r.BondDenom)
+		}
+		reweightings[r.BondDenom] = true
+	}
+
+	stakingCaps := make(map[string]bool)
+	for _, c := range gs.StakingCaps {
+		if !weights[c.BondDenom] {
+			return fmt.Errorf("capped bond denom %s has no weight", c.BondDenom)
+		}
+		if stakingCaps[c.BondDenom] {
+			return fmt.Errorf("duplicate staking caps for %s", c.BondDenom)
+		}
+		if err := c.Caps.Validate(); err != nil {
+			return err
+		}
+		stakingCaps[c.BondDenom] = true
+	}
+
+	weightBounds := make(map[string]bool)
+	for _, b := range gs.WeightBounds {
+		if !weights[b.BondDenom] {
+			return fmt.Errorf("dynamic bond denom %s has no weight", b.BondDenom)
+		}
+		if weightBounds[b.BondDenom] {
+			return fmt.Errorf("duplicate weight bounds for %s", b.BondDenom)
+		}
+		if err := b.Bounds.Validate(); err != nil {
+			return err
+		}
+		weightBounds[b.BondDenom] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,3775 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: multistaking/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the multi-staking module's genesis state.
+type GenesisState struct {
+	// params defines all the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// bond_token_weights defines the accepted bond denoms and their weights.
+	BondTokenWeights []BondTokenWeight `protobuf:"bytes,2,rep,name=bond_token_weights,json=bondTokenWeights,proto3" json:"bond_token_weights"`
+	// validator_bond_denoms defines the bond denom each validator is pinned to.
+	ValidatorBondDenoms []ValidatorBondDenom `protobuf:"bytes,3,rep,name=validator_bond_denoms,json=validatorBondDenoms,proto3" json:"validator_bond_denoms"`
+	// intermediary_account_delegators defines the delegator each intermediary
+	// account acts for.
+	IntermediaryAccountDelegators []IntermediaryAccountDelegator `protobuf:"bytes,4,rep,name=intermediary_account_delegators,json=intermediaryAccountDelegators,proto3" json:"intermediary_account_delegators"`
+	// dv_pair_sdk_bond_tokens defines the sdkbond tokens minted for each DV pair.
+	DVPairSDKBondTokens []DVPairSDKBondTokens `protobuf:"bytes,5,rep,name=dv_pair_sdk_bond_tokens,json=dvPairSdkBondTokens,proto3" json:"dv_pair_sdk_bond_tokens"`
+	// dv_pair_bond_tokens defines the bond tokens locked for each DV pair.
+	DVPairBondTokens []DVPairBondTokens `protobuf:"bytes,6,rep,name=dv_pair_bond_tokens,json=dvPairBondTokens,proto3" json:"dv_pair_bond_tokens"`
+	// dv_pair_unbonding_tokens defines the unbonding tokens of each DV pair.
+	DVPairUnbondingTokens []DVPairUnbondingTokens `protobuf:"bytes,7,rep,name=dv_pair_unbonding_tokens,json=dvPairUnbondingTokens,proto3" json:"dv_pair_unbonding_tokens"`
+	// bond_denom_sunset_heights defines the bond denoms being removed.
+	BondDenomSunsetHeights []BondDenomSunsetHeight `protobuf:"bytes,8,rep,name=bond_denom_sunset_heights,json=bondDenomSunsetHeights,proto3" json:"bond_denom_sunset_heights"`
+	// reweightings defines the ongoing reweighting jobs.
+	Reweightings []BondDenomReweighting `protobuf:"bytes,9,rep,name=reweightings,proto3" json:"reweightings"`
+	// staking_caps defines the staking caps of the capped bond denoms.
+	StakingCaps []BondDenomStakingCaps `protobuf:"bytes,10,rep,name=staking_caps,json=stakingCaps,proto3" json:"staking_caps"`
+	// weight_bounds defines the weight bounds of the bond denoms with a dynamic
+	// weight.
+	WeightBounds []BondDenomWeightBounds `protobuf:"bytes,11,rep,name=weight_bounds,json=weightBounds,proto3" json:"weight_bounds"`
+	// issued_sdk_bond_tokens defines the sdkbond tokens issued by the module for
+	// the DV pairs and not retired yet, which are the sdkbond tokens of the DV
+	// pairs plus those of their unbonding tokens.
+	IssuedSDKBondTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=issued_sdk_bond_tokens,json=issuedSdkBondTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"issued_sdk_bond_tokens"`
+	// sunset_cursors defines the DV pair key the force-undelegation of each
+	// sunsetting bond denom continues from.
+	SunsetCursors []BondDenomSunsetCursor `protobuf:"bytes,13,rep,name=sunset_cursors,json=sunsetCursors,proto3" json:"sunset_cursors"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f95a201ebed173c, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetBondTokenWeights() []BondTokenWeight {
+	if m != nil {
+		return m.BondTokenWeights
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorBondDenoms() []ValidatorBondDenom {
+	if m != nil {
+		return m.ValidatorBondDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIntermediaryAccountDelegators() []IntermediaryAccountDelegator {
+	if m != nil {
+		return m.IntermediaryAccountDelegators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDVPairSDKBondTokens() []DVPairSDKBondTokens {
+	if m != nil {
+		return m.DVPairSDKBondTokens
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDVPairBondTokens() []DVPairBondTokens {
+	if m != nil {
+		return m.DVPairBondTokens
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDVPairUnbondingTokens() []DVPairUnbondingTokens {
+	if m != nil {
+		return m.DVPairUnbondingTokens
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBondDenomSunsetHeights() []BondDenomSunsetHeight {
+	if m != nil {
+		return m.BondDenomSunsetHeights
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReweightings() []BondDenomReweighting {
+	if m != nil {
+		return m.Reweightings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStakingCaps() []BondDenomStakingCaps {
+	if m != nil {
+		return m.StakingCaps
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWeightBounds() []BondDenomWeightBounds {
+	if m != nil {
+		return m.WeightBounds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSunsetCursors() []BondDenomSunsetCursor {
+	if m != nil {
+		return m.SunsetCursors
+	}
+	return nil
+}
+
+// BondTokenWeight defines the weight of a bond denom.
+type BondTokenWeight struct {
+	BondDenom       string                                 `protobuf:"bytes,1,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
+	BondTokenWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=bond_token_weight,json=bondTokenWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bond_token_weight"`
+}
+
+func (m *BondTokenWeight) Reset()         { *m = BondTokenWeight{} }
+func (m *BondTokenWeight) String() string { return proto.CompactTextString(m) }
+func (*BondTokenWeight) ProtoMessage()    {}
+func (*BondTokenWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f95a201ebed173c, []int{1}
+}
+func (m *BondTokenWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BondTokenWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BondTokenWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BondTokenWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BondTokenWeight.Merge(m, src)
+}
+func (m *BondTokenWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *BondTokenWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_BondTokenWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BondTokenWeight proto.InternalMessageInfo
+
+func (m *BondTokenWeight) GetBondDenom() string {
+	if m != nil {
+		return m.BondDenom
+	}
+	return ""
+}
+
+// ValidatorBondDenom defines the bond denom a validator is pinned to.
+type ValidatorBondDenom struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	BondDenom        string `protobuf:"bytes,2,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
+}
+
+func (m *ValidatorBondDenom) Reset()         { *m = ValidatorBondDenom{} }
+func (m *ValidatorBondDenom) String() string { return proto.CompactTextString(m) }
+func (*ValidatorBondDenom) ProtoMessage()    {}
+func (*ValidatorBondDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f95a201ebed173c, []int{2}
+}
+func (m *ValidatorBondDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorBondDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorBondDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorBondDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorBondDenom.Merge(m, src)
+}
+func (m *ValidatorBondDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorBondDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorBondDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorBondDenom proto.InternalMessageInfo
+
+func (m *ValidatorBondDenom) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorBondDenom) GetBondDenom() string {
+	if m != nil {
+		return m.BondDenom
+	}
+	return ""
+}
+
+// IntermediaryAccountDelegator defines the delegator an intermediary account
+// acts for.
+type IntermediaryAccountDelegator struct {
+	IntermediaryAddress string `protobuf:"bytes,1,opt,name=intermediary_address,json=intermediaryAddress,proto3" json:"intermediary_address,omitempty"`
+	DelegatorAddress    string `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *IntermediaryAccountDelegator) Reset()         { *m = IntermediaryAccountDelegator{} }
+func (m *IntermediaryAccountDelegator) String() string { return proto.CompactTextString(m) }
+func (*IntermediaryAccountDelegator) ProtoMessage()    {}
+func (*IntermediaryAccountDelegator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f95a201ebed173c, []int{3}
+}
+func (m *IntermediaryAccountDelegator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IntermediaryAccountDelegator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IntermediaryAccountDelegator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IntermediaryAccountDelegator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IntermediaryAccountDelegator.Merge(m, src)
+}
+func (m *IntermediaryAccountDelegator) XXX_Size() int {
+	return m.Size()
+}
+func (m *IntermediaryAccountDelegator) XXX_DiscardUnknown() {
+	xxx_messageInfo_IntermediaryAccountDelegator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IntermediaryAccountDelegator proto.InternalMessageInfo
+
+func (m *IntermediaryAccountDelegator) GetIntermediaryAddress() string {
+	if m != nil {
+		return m.IntermediaryAddress
+	}
+	return ""
+}
+
+func (m *IntermediaryAccountDelegator) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+// DVPairSDKBondTokens defines the sdkbond tokens minted for a DV pair.
+type DVPairSDKBondTokens struct {
+	DelegatorAddress string     `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string     `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	SDKBondTokens    types.Coin `protobuf:"bytes,3,opt,name=sdk_bond_tokens,json=sdkBondTokens,proto3" json:"sdk_bond_tokens"`
+}
+
+func (m *DVPairSDKBondTokens) Reset()         { *m = DVPairSDKBondTokens{} }
+func (m *DVPairSDKBondTokens) String() string { return proto.CompactTextString(m) }
+func (*DVPairSDKBondTokens) ProtoMessage()    {}
+func (*DVPairSDKBondTokens) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f95a201ebed173c, []int{4}
+}
+func (m *DVPairSDKBondTokens) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DVPairSDKBondTokens) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DVPairSDKBondTokens.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DVPairSDKBondTokens) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DVPairSDKBondTokens.Merge(m, src)
+}
+func (m *DVPairSDKBondTokens) XXX_Size() int {
+	return m.Size()
+}
+func (m *DVPairSDKBondTokens) XXX_DiscardUnknown() {
+	xxx_messageInfo_DVPairSDKBondTokens.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DVPairSDKBondTokens proto.InternalMessageInfo
+
+func (m *DVPairSDKBondTokens) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *DVPairSDKBondTokens) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *DVPairSDKBondTokens) GetSDKBondTokens() types.Coin {
+	if m != nil {
+		return m.SDKBondTokens
+	}
+	return types.Coin{}
+}
+
+// DVPairBondTokens defines the bond tokens locked for a DV pair.
+type DVPairBondTokens struct {
+	DelegatorAddress string     `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string     `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	BondTokens       types.Coin `protobuf:"bytes,3,opt,name=bond_tokens,json=bondTokens,proto3" json:"bond_tokens"`
+}
+
+func (m *DVPairBondTokens) Reset()         { *m = DVPairBondTokens{} }
+func (m *DVPairBondTokens) String() string { return proto.CompactTextString(m) }
+func (*DVPairBondTokens) ProtoMessage()    {}
+func (*DVPairBondTokens) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f95a201ebed173c, []int{5}
+}
+func (m *DVPairBondTokens) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DVPairBondTokens) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DVPairBondTokens.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DVPairBondTokens) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DVPairBondTokens.Merge(m, src)
+}
+func (m *DVPairBondTokens) XXX_Size() int {
+	return m.Size()
+}
+func (m *DVPairBondTokens) XXX_DiscardUnknown() {
+	xxx_messageInfo_DVPairBondTokens.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DVPairBondTokens proto.InternalMessageInfo
+
+func (m *DVPairBondTokens) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *DVPairBondTokens) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *DVPairBondTokens) GetBondTokens() types.Coin {
+	if m != nil {
+		return m.BondTokens
+	}
+	return types.Coin{}
+}
+
+// DVPairUnbondingTokens defines the tokens of a DV pair unbonding until a
+// completion time.
+type DVPairUnbondingTokens struct {
+	DelegatorAddress string          `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string          `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	CompletionTime   time.Time       `protobuf:"bytes,3,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+	UnbondingTokens  UnbondingTokens `protobuf:"bytes,4,opt,name=unbonding_tokens,json=unbondingTokens,proto3" json:"unbonding_tokens"`
+}
+
+func (m *DVPairUnbondingTokens) Reset()         { *m = DVPairUnbondingTokens{} }
+func (m *DVPairUnbondingTokens) String() string { return proto.CompactTextString(m) }
+func (*DVPairUnbondingTokens) ProtoMessage()    {}
+func (*DVPairUnbondingTokens) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f95a201ebed173c, []int{6}
+}
+func (m *DVPairUnbondingTokens) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DVPairUnbondingTokens) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DVPairUnbondingTokens.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DVPairUnbondingTokens) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DVPairUnbondingTokens.Merge(m, src)
+}
+func (m *DVPairUnbondingTokens) XXX_Size() int {
+	return m.Size()
+}
+func (m *DVPairUnbondingTokens) XXX_DiscardUnknown() {
+	xxx_messageInfo_DVPairUnbondingTokens.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DVPairUnbondingTokens proto.InternalMessageInfo
+
+func (m *DVPairUnbondingTokens) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *DVPairUnbondingTokens) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *DVPairUnbondingTokens) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
+func (m *DVPairUnbondingTokens) GetUnbondingTokens() UnbondingTokens {
+	if m != nil {
+		return m.UnbondingTokens
+	}
+	return UnbondingTokens{}
+}
+
+// BondDenomSunsetHeight defines the height at which the removal of a bond
+// denom started.
+type BondDenomSunsetHeight struct {
+	BondDenom string `protobuf:"bytes,1,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
+	Height    int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *BondDenomSunsetHeight) Reset()         { *m = BondDenomSunsetHeight{} }
+func (m *BondDenomSunsetHeight) String() string { return proto.CompactTextString(m) }
+func (*BondDenomSunsetHeight) ProtoMessage()    {}
+func (*BondDenomSunsetHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f95a201ebed173c, []int{7}
+}
+func (m *BondDenomSunsetHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BondDenomSunsetHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BondDenomSunsetHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BondDenomSunsetHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BondDenomSunsetHeight.Merge(m, src)
+}
+func (m *BondDenomSunsetHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *BondDenomSunsetHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_BondDenomSunsetHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BondDenomSunsetHeight proto.InternalMessageInfo
+
+func (m *BondDenomSunsetHeight) GetBondDenom() string {
+	if m != nil {
+		return m.BondDenom
+	}
+	return ""
+}
+
+func (m *BondDenomSunsetHeight) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// BondDenomSunsetCursor defines the DV pair key the force-undelegation of a
+// sunsetting bond denom continues from.
+type BondDenomSunsetCursor struct {
+	BondDenom  string `protobuf:"bytes,1,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
+	NextDvPair []byte `protobuf:"bytes,2,opt,name=next_dv_pair,json=nextDvPair,proto3" json:"next_dv_pair,omitempty"`
+}
+
+func (m *BondDenomSunsetCursor) Reset()         { *m = BondDenomSunsetCursor{} }
+func (m *BondDenomSunsetCursor) String() string { return proto.CompactTextString(m) }
+func (*BondDenomSunsetCursor) ProtoMessage()    {}
+func (*BondDenomSunsetCursor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f95a201ebed173c, []int{8}
+}
+func (m *BondDenomSunsetCursor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BondDenomSunsetCursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BondDenomSunsetCursor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BondDenomSunsetCursor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BondDenomSunsetCursor.Merge(m, src)
+}
+func (m *BondDenomSunsetCursor) XXX_Size() int {
+	return m.Size()
+}
+func (m *BondDenomSunsetCursor) XXX_DiscardUnknown() {
+	xxx_messageInfo_BondDenomSunsetCursor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BondDenomSunsetCursor proto.InternalMessageInfo
+
+func (m *BondDenomSunsetCursor) GetBondDenom() string {
+	if m != nil {
+		return m.BondDenom
+	}
+	return ""
+}
+
+func (m *BondDenomSunsetCursor) GetNextDvPair() []byte {
+	if m != nil {
+		return m.NextDvPair
+	}
+	return nil
+}
+
+// BondDenomReweighting defines the reweighting job of a bond denom.
+type BondDenomReweighting struct {
+	BondDenom   string      `protobuf:"bytes,1,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
+	Reweighting Reweighting `protobuf:"bytes,2,opt,name=reweighting,proto3" json:"reweighting"`
+}
+
+func (m *BondDenomReweighting) Reset()         { *m = BondDenomReweighting{} }
+func (m *BondDenomReweighting) String() string { return proto.CompactTextString(m) }
+func (*BondDenomReweighting) ProtoMessage()    {}
+func (*BondDenomReweighting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f95a201ebed173c, []int{9}
+}
+func (m *BondDenomReweighting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BondDenomReweighting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BondDenomReweighting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BondDenomReweighting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BondDenomReweighting.Merge(m, src)
+}
+func (m *BondDenomReweighting) XXX_Size() int {
+	return m.Size()
+}
+func (m *BondDenomReweighting) XXX_DiscardUnknown() {
+	xxx_messageInfo_BondDenomReweighting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BondDenomReweighting proto.InternalMessageInfo
+
+func (m *BondDenomReweighting) GetBondDenom() string {
+	if m != nil {
+		return m.BondDenom
+	}
+	return ""
+}
+
+func (m *BondDenomReweighting) GetReweighting() Reweighting {
+	if m != nil {
+		return m.Reweighting
+	}
+	return Reweighting{}
+}
+
+// BondDenomStakingCaps defines the staking caps of a bond denom.
+type BondDenomStakingCaps struct {
+	BondDenom string      `protobuf:"bytes,1,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
+	Caps      StakingCaps `protobuf:"bytes,2,opt,name=caps,proto3" json:"caps"`
+}
+
+func (m *BondDenomStakingCaps) Reset()         { *m = BondDenomStakingCaps{} }
+func (m *BondDenomStakingCaps) String() string { return proto.CompactTextString(m) }
+func (*BondDenomStakingCaps) ProtoMessage()    {}
+func (*BondDenomStakingCaps) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f95a201ebed173c, []int{10}
+}
+func (m *BondDenomStakingCaps) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BondDenomStakingCaps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BondDenomStakingCaps.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BondDenomStakingCaps) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BondDenomStakingCaps.Merge(m, src)
+}
+func (m *BondDenomStakingCaps) XXX_Size() int {
+	return m.Size()
+}
+func (m *BondDenomStakingCaps) XXX_DiscardUnknown() {
+	xxx_messageInfo_BondDenomStakingCaps.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BondDenomStakingCaps proto.InternalMessageInfo
+
+func (m *BondDenomStakingCaps) GetBondDenom() string {
+	if m != nil {
+		return m.BondDenom
+	}
+	return ""
+}
+
+func (m *BondDenomStakingCaps) GetCaps() StakingCaps {
+	if m != nil {
+		return m.Caps
+	}
+	return StakingCaps{}
+}
+
+// BondDenomWeightBounds defines the weight bounds of a bond denom.
+type BondDenomWeightBounds struct {
+	BondDenom string       `protobuf:"bytes,1,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
+	Bounds    WeightBounds `protobuf:"bytes,2,opt,name=bounds,proto3" json:"bounds"`
+}
+
+func (m *BondDenomWeightBounds) Reset()         { *m = BondDenomWeightBounds{} }
+func (m *BondDenomWeightBounds) String() string { return proto.CompactTextString(m) }
+func (*BondDenomWeightBounds) ProtoMessage()    {}
+func (*BondDenomWeightBounds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f95a201ebed173c, []int{11}
+}
+func (m *BondDenomWeightBounds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BondDenomWeightBounds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BondDenomWeightBounds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BondDenomWeightBounds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BondDenomWeightBounds.Merge(m, src)
+}
+func (m *BondDenomWeightBounds) XXX_Size() int {
+	return m.Size()
+}
+func (m *BondDenomWeightBounds) XXX_DiscardUnknown() {
+	xxx_messageInfo_BondDenomWeightBounds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BondDenomWeightBounds proto.InternalMessageInfo
+
+func (m *BondDenomWeightBounds) GetBondDenom() string {
+	if m != nil {
+		return m.BondDenom
+	}
+	return ""
+}
+
+func (m *BondDenomWeightBounds) GetBounds() WeightBounds {
+	if m != nil {
+		return m.Bounds
+	}
+	return WeightBounds{}
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "multistaking.v1.GenesisState")
+	proto.RegisterType((*BondTokenWeight)(nil), "multistaking.v1.BondTokenWeight")
+	proto.RegisterType((*ValidatorBondDenom)(nil), "multistaking.v1.ValidatorBondDenom")
+	proto.RegisterType((*IntermediaryAccountDelegator)(nil), "multistaking.v1.IntermediaryAccountDelegator")
+	proto.RegisterType((*DVPairSDKBondTokens)(nil), "multistaking.v1.DVPairSDKBondTokens")
+	proto.RegisterType((*DVPairBondTokens)(nil), "multistaking.v1.DVPairBondTokens")
+	proto.RegisterType((*DVPairUnbondingTokens)(nil), "multistaking.v1.DVPairUnbondingTokens")
+	proto.RegisterType((*BondDenomSunsetHeight)(nil), "multistaking.v1.BondDenomSunsetHeight")
+	proto.RegisterType((*BondDenomSunsetCursor)(nil), "multistaking.v1.BondDenomSunsetCursor")
+	proto.RegisterType((*BondDenomReweighting)(nil), "multistaking.v1.BondDenomReweighting")
+	proto.RegisterType((*BondDenomStakingCaps)(nil), "multistaking.v1.BondDenomStakingCaps")
+	proto.RegisterType((*BondDenomWeightBounds)(nil), "multistaking.v1.BondDenomWeightBounds")
+}
+
+func init() { proto.RegisterFile("multistaking/v1/genesis.proto", fileDescriptor_8f95a201ebed173c) }
+
+var fileDescriptor_8f95a201ebed173c = []byte{
+	// 1095 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4f, 0x73, 0xdb, 0x44,
+	0x14, 0x8f, 0x9c, 0x10, 0x9a, 0x67, 0xa7, 0x4e, 0xd6, 0x49, 0xaa, 0x84, 0xc6, 0x36, 0x2a, 0x74,
+	0x72, 0xb1, 0x3c, 0x09, 0x03, 0x17, 0x38, 0x50, 0xc7, 0x1d, 0xe8, 0x74, 0x28, 0xad, 0x1c, 0x4a,
+	0x87, 0x99, 0x8e, 0x46, 0x7f, 0x16, 0x45, 0xc4, 0xd2, 0x7a, 0xb4, 0x92, 0xd3, 0xd2, 0x19, 0xce,
+	0x1c, 0x7b, 0x85, 0x8f, 0xc1, 0xf4, 0x3b, 0xd0, 0x63, 0xa7, 0x27, 0x86, 0x43, 0x60, 0x92, 0xcf,
+	0xc1, 0x0c, 0xa3, 0xdd, 0x95, 0x25, 0x59, 0x72, 0xdd, 0xdc, 0x7a, 0x8a, 0xf7, 0xfd, 0xf9, 0xfd,
+	0xf6, 0xad, 0xde, 0xbe, 0xdf, 0x06, 0x76, 0xbd, 0x68, 0x18, 0xba, 0x34, 0x34, 0x4e, 0x5c, 0xdf,
+	0xe9, 0x8e, 0xf7, 0xbb, 0x0e, 0xf6, 0x31, 0x75, 0xa9, 0x3a, 0x0a, 0x48, 0x48, 0x50, 0x3d, 0xeb,
+	0x56, 0xc7, 0xfb, 0x3b, 0x2d, 0x87, 0x10, 0x67, 0x88, 0xbb, 0xcc, 0x6d, 0x46, 0x3f, 0x76, 0x43,
+	0xd7, 0xc3, 0x34, 0x34, 0xbc, 0x11, 0xcf, 0xd8, 0xd9, 0x70, 0x88, 0x43, 0xd8, 0xcf, 0x6e, 0xfc,
+	0x4b, 0x58, 0xb7, 0x2d, 0x42, 0x3d, 0x42, 0x75, 0xee, 0xe0, 0x0b, 0xe1, 0x6a, 0xf2, 0x55, 0xd7,
+	0x34, 0x28, 0xee, 0x8e, 0xf7, 0x4d, 0x1c, 0x1a, 0xfb, 0x5d, 0x8b, 0xb8, 0xbe, 0xf0, 0xdf, 0x98,
+	0xde, 0x21, 0x5b, 0xeb, 0xc9, 0x9e, 0x58, 0x90, 0xf2, 0x1b, 0x40, 0xed, 0x2b, 0xbe, 0xf3, 0x41,
+	0x68, 0x84, 0x18, 0x7d, 0x0a, 0xcb, 0x23, 0x23, 0x30, 0x3c, 0x2a, 0x4b, 0x6d, 0x69, 0xaf, 0x7a,
+	0x70, 0x4d, 0x9d, 0xaa, 0x44, 0xbd, 0xcf, 0xdc, 0xbd, 0xa5, 0x97, 0x67, 0xad, 0x05, 0x4d, 0x04,
+	0xa3, 0x23, 0x40, 0x26, 0xf1, 0x6d, 0x3d, 0x24, 0x27, 0xd8, 0xd7, 0x4f, 0xb1, 0xeb, 0x1c, 0x87,
+	0x54, 0xae, 0xb4, 0x17, 0xf7, 0xaa, 0x07, 0xed, 0x02, 0x44, 0x8f, 0xf8, 0xf6, 0x51, 0x1c, 0xf9,
+	0x3d, 0x0b, 0x14, 0x58, 0x6b, 0x66, 0xde, 0x4c, 0xd1, 0x63, 0xd8, 0x1c, 0x1b, 0x43, 0xd7, 0x36,
+	0x42, 0x12, 0xe8, 0x0c, 0xdf, 0xc6, 0x3e, 0xf1, 0xa8, 0xbc, 0xc8, 0x80, 0x6f, 0x14, 0x80, 0x1f,
+	0x26, 0xd1, 0x31, 0x43, 0x3f, 0x8e, 0x15, 0xd8, 0x8d, 0x71, 0xc1, 0x43, 0xd1, 0x33, 0x68, 0xb9,
+	0x7e, 0x88, 0x03, 0x0f, 0xdb, 0xae, 0x11, 0x3c, 0xd5, 0x0d, 0xcb, 0x22, 0x91, 0x1f, 0xea, 0x36,
+	0x1e, 0x62, 0x27, 0x8e, 0xa5, 0xf2, 0x12, 0x23, 0xea, 0x14, 0x88, 0xee, 0x64, 0xf2, 0x6e, 0xf1,
+	0xb4, 0x7e, 0x92, 0x25, 0x28, 0x77, 0xdd, 0x37, 0xc4, 0x50, 0x74, 0x0a, 0xd7, 0xec, 0xb1, 0x3e,
+	0x32, 0xdc, 0x40, 0xa7, 0xf6, 0x89, 0x9e, 0x9e, 0x1e, 0x95, 0xdf, 0x63, 0xa4, 0x1f, 0x15, 0x48,
+	0xfb, 0x0f, 0xef, 0x1b, 0x6e, 0x30, 0xe8, 0xdf, 0x9d, 0x9c, 0x1f, 0xed, 0x7d, 0x10, 0x73, 0x9d,
+	0x9f, 0xb5, 0x1a, 0x25, 0x4e, 0xad, 0x61, 0x8f, 0x99, 0xd1, 0x3e, 0x49, 0x8d, 0xe8, 0x27, 0x68,
+	0x24, 0xc4, 0x59, 0xd2, 0x65, 0x46, 0xfa, 0xe1, 0x0c, 0xd2, 0x0c, 0xa3, 0x2c, 0x18, 0xd7, 0xa6,
+	0x3d, 0xda, 0x9a, 0x3d, 0xce, 0x5b, 0xd0, 0x2f, 0x20, 0x27, 0x5c, 0x91, 0x1f, 0xb3, 0xb9, 0xbe,
+	0x93, 0x10, 0xbe, 0xcf, 0x08, 0x6f, 0xce, 0x20, 0xfc, 0x2e, 0x09, 0x17, 0xac, 0xbb, 0x82, 0x75,
+	0xb3, 0xd4, 0xad, 0x6d, 0xda, 0xe3, 0x12, 0x33, 0x72, 0x60, 0x3b, 0x6d, 0x1b, 0x9d, 0x46, 0x3e,
+	0xc5, 0xa1, 0x7e, 0x2c, 0xba, 0xf3, 0xca, 0x8c, 0x0d, 0x4c, 0x3a, 0x64, 0xc0, 0xe2, 0xbf, 0xce,
+	0xf6, 0xe8, 0x96, 0x59, 0xe6, 0xa4, 0xe8, 0x5b, 0xa8, 0x05, 0x98, 0xb7, 0xbd, 0xeb, 0x3b, 0x54,
+	0x5e, 0x61, 0xd8, 0x1f, 0xcf, 0xc6, 0xd6, 0xd2, 0x68, 0x01, 0x9d, 0x03, 0x40, 0xf7, 0xa0, 0x26,
+	0xd2, 0x74, 0xcb, 0x18, 0x51, 0x19, 0xe6, 0x01, 0x0e, 0xb8, 0xed, 0xd0, 0x18, 0x25, 0x77, 0xb3,
+	0x4a, 0x53, 0x13, 0x7a, 0x00, 0xab, 0x1c, 0x5d, 0x37, 0x49, 0xe4, 0xdb, 0x54, 0xae, 0xce, 0xab,
+	0x5e, 0xdc, 0x4d, 0x16, 0x9d, 0x6c, 0xf1, 0x34, 0x63, 0x43, 0xbf, 0x4a, 0xb0, 0xe5, 0x52, 0x1a,
+	0x61, 0xbb, 0xd0, 0xc1, 0xb5, 0xb6, 0xb4, 0xb7, 0xd2, 0x1b, 0xc4, 0x49, 0x7f, 0x9f, 0xb5, 0x6e,
+	0x3a, 0x6e, 0x78, 0x1c, 0x99, 0xaa, 0x45, 0x3c, 0x31, 0xc2, 0xc4, 0x9f, 0x0e, 0xb5, 0x4f, 0xba,
+	0xe1, 0xd3, 0x11, 0xa6, 0xf1, 0x85, 0x8a, 0xbb, 0xf8, 0x0e, 0xc3, 0xcb, 0x75, 0xf1, 0xeb, 0x17,
+	0x1d, 0xe0, 0xe1, 0x71, 0x90, 0xd6, 0xe0, 0x94, 0xf9, 0x9e, 0x1e, 0xc0, 0x55, 0xf1, 0x71, 0xad,
+	0x28, 0xa0, 0xf1, 0xc5, 0x5d, 0x7d, 0xbb, 0x8f, 0x7b, 0xc8, 0xc2, 0x45, 0x79, 0xab, 0x34, 0x63,
+	0xa3, 0xca, 0xef, 0x12, 0xd4, 0xa7, 0x26, 0x15, 0xda, 0x05, 0x48, 0x1b, 0x8a, 0x8d, 0xc8, 0x15,
+	0x6d, 0x65, 0xd2, 0x13, 0xe8, 0x18, 0xd6, 0x0b, 0x63, 0x50, 0xae, 0xb0, 0xc3, 0xf8, 0xe2, 0x12,
+	0x87, 0xd1, 0xc7, 0x56, 0xa6, 0xea, 0x3e, 0xb6, 0xb4, 0xfa, 0xd4, 0x6c, 0x54, 0x7e, 0x06, 0x54,
+	0x1c, 0x76, 0xe8, 0x36, 0xac, 0xa7, 0x03, 0xd3, 0xb0, 0xed, 0x00, 0x53, 0x3e, 0xc8, 0x57, 0x7a,
+	0xf2, 0xeb, 0x17, 0x9d, 0x0d, 0x81, 0x78, 0x8b, 0x7b, 0x06, 0x61, 0xe0, 0xfa, 0x8e, 0xb6, 0x36,
+	0x49, 0x11, 0xf6, 0xa9, 0x2a, 0x2b, 0x53, 0x55, 0x2a, 0x7f, 0x48, 0x70, 0xfd, 0x4d, 0x03, 0x10,
+	0xdd, 0x85, 0x8d, 0xfc, 0x60, 0x7d, 0xcb, 0x9d, 0x34, 0x72, 0x23, 0x53, 0x6c, 0xe6, 0x36, 0xac,
+	0x4f, 0x06, 0xf2, 0x04, 0xa9, 0x32, 0xaf, 0xa6, 0x49, 0x8a, 0xb0, 0x2b, 0xff, 0x49, 0x50, 0x36,
+	0x23, 0xcb, 0xe1, 0xa5, 0xcb, 0xc2, 0x97, 0x9f, 0x7c, 0xe5, 0xd2, 0x27, 0xff, 0x08, 0xea, 0xd3,
+	0x77, 0x69, 0x91, 0xe9, 0xf0, 0xb6, 0x2a, 0x10, 0x62, 0xb9, 0x57, 0x85, 0xdc, 0xab, 0x87, 0xc4,
+	0xf5, 0x7b, 0x9b, 0x62, 0x34, 0xae, 0xe6, 0x87, 0xff, 0x2a, 0xcd, 0x5e, 0x11, 0xe5, 0x5c, 0x82,
+	0xc2, 0xc4, 0x7e, 0xc7, 0x8a, 0xff, 0x12, 0xaa, 0x97, 0x2a, 0x9c, 0xdf, 0x5a, 0x30, 0xd3, 0x22,
+	0xff, 0xac, 0x40, 0xb9, 0x40, 0xbc, 0x63, 0x95, 0x7e, 0x03, 0x75, 0x8b, 0x78, 0xa3, 0x21, 0x0e,
+	0x5d, 0xe2, 0xeb, 0xf1, 0x53, 0x50, 0x54, 0xbb, 0xa3, 0xf2, 0x77, 0xa2, 0x9a, 0xbc, 0x13, 0xd5,
+	0xa3, 0xe4, 0x9d, 0xd8, 0xbb, 0x12, 0x97, 0xfb, 0xfc, 0x9f, 0x96, 0xa4, 0x5d, 0x4d, 0x93, 0x63,
+	0x37, 0x7a, 0x00, 0x6b, 0x05, 0x79, 0x5d, 0x6a, 0x4b, 0xa5, 0x6f, 0xaf, 0x69, 0x61, 0xe5, 0x87,
+	0x58, 0x8f, 0xf2, 0x66, 0xe5, 0x1e, 0x6c, 0x96, 0xea, 0xe0, 0xbc, 0x09, 0xb8, 0x05, 0xcb, 0xc7,
+	0xe9, 0xd8, 0x5b, 0xd4, 0xc4, 0x4a, 0x79, 0x54, 0xc0, 0xe3, 0x63, 0x76, 0x1e, 0x5e, 0x1b, 0x6a,
+	0x3e, 0x7e, 0x12, 0xea, 0xe2, 0x19, 0xc1, 0x50, 0x6b, 0x1a, 0xc4, 0xb6, 0x3e, 0x93, 0x7c, 0xe5,
+	0x19, 0x6c, 0x94, 0xa9, 0xea, 0x3c, 0xe0, 0x3e, 0x54, 0x33, 0x82, 0xcb, 0x70, 0xab, 0x07, 0xd7,
+	0x0b, 0xc7, 0x55, 0xd4, 0xe9, 0x6c, 0x9a, 0xe2, 0xc1, 0x46, 0x99, 0x02, 0xcf, 0x23, 0xff, 0x0c,
+	0x96, 0x98, 0xaa, 0xcf, 0x62, 0x2d, 0x8a, 0x39, 0x8b, 0x57, 0x68, 0xe6, 0x14, 0xb3, 0xfa, 0x3c,
+	0x8f, 0xef, 0x73, 0x58, 0x16, 0xb2, 0xcf, 0x19, 0x77, 0x0b, 0x8c, 0x25, 0x6a, 0x2f, 0x52, 0x7a,
+	0x8f, 0x5f, 0x9e, 0x37, 0xa5, 0x57, 0xe7, 0x4d, 0xe9, 0xdf, 0xf3, 0xa6, 0xf4, 0xfc, 0xa2, 0xb9,
+	0xf0, 0xea, 0xa2, 0xb9, 0xf0, 0xd7, 0x45, 0x73, 0xe1, 0x87, 0xc3, 0x8c, 0x96, 0xf9, 0x24, 0x6e,
+	0x47, 0x63, 0xd8, 0x19, 0x1a, 0x26, 0xe5, 0xff, 0x6b, 0x74, 0x04, 0x7e, 0xc7, 0x23, 0x76, 0x34,
+	0xc4, 0xdd, 0x27, 0x79, 0x33, 0x17, 0x3b, 0x73, 0x99, 0xb5, 0xfa, 0x27, 0xff, 0x0f, 0x00, 0x90,
+	0x18, 0xe2, 0x50, 0x52, 0x0d, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SunsetCursors) > 0 {
+		for iNdEx := len(m.SunsetCursors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SunsetCursors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	{
+		size := m.IssuedSDKBondTokens.Size()
+		i -= size
+		if _, err := m.IssuedSDKBondTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.WeightBounds) > 0 {
+		for iNdEx := len(m.WeightBounds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WeightBounds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.StakingCaps) > 0 {
+		for iNdEx := len(m.StakingCaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakingCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Reweightings) > 0 {
+		for iNdEx := len(m.Reweightings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reweightings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.BondDenomSunsetHeights) > 0 {
+		for iNdEx := len(m.BondDenomSunsetHeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BondDenomSunsetHeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.DVPairUnbondingTokens) > 0 {
+		for iNdEx := len(m.DVPairUnbondingTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DVPairUnbondingTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.DVPairBondTokens) > 0 {
+		for iNdEx := len(m.DVPairBondTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DVPairBondTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DVPairSDKBondTokens) > 0 {
+		for iNdEx := len(m.DVPairSDKBondTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DVPairSDKBondTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.IntermediaryAccountDelegators) > 0 {
+		for iNdEx := len(m.IntermediaryAccountDelegators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IntermediaryAccountDelegators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ValidatorBondDenoms) > 0 {
+		for iNdEx := len(m.ValidatorBondDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorBondDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BondTokenWeights) > 0 {
+		for iNdEx := len(m.BondTokenWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BondTokenWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BondTokenWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BondTokenWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BondTokenWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BondTokenWeight.Size()
+		i -= size
+		if _, err := m.BondTokenWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.BondDenom) > 0 {
+		i -= len(m.BondDenom)
+		copy(dAtA[i:], m.BondDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BondDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorBondDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorBondDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorBondDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BondDenom) > 0 {
+		i -= len(m.BondDenom)
+		copy(dAtA[i:], m.BondDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BondDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IntermediaryAccountDelegator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IntermediaryAccountDelegator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IntermediaryAccountDelegator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IntermediaryAddress) > 0 {
+		i -= len(m.IntermediaryAddress)
+		copy(dAtA[i:], m.IntermediaryAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.IntermediaryAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DVPairSDKBondTokens) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DVPairSDKBondTokens) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DVPairSDKBondTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SDKBondTokens.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DVPairBondTokens) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DVPairBondTokens) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DVPairBondTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BondTokens.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DVPairUnbondingTokens) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DVPairUnbondingTokens) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DVPairUnbondingTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.UnbondingTokens.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintGenesis(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BondDenomSunsetHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BondDenomSunsetHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BondDenomSunsetHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BondDenom) > 0 {
+		i -= len(m.BondDenom)
+		copy(dAtA[i:], m.BondDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BondDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BondDenomSunsetCursor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BondDenomSunsetCursor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BondDenomSunsetCursor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextDvPair) > 0 {
+		i -= len(m.NextDvPair)
+		copy(dAtA[i:], m.NextDvPair)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.NextDvPair)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BondDenom) > 0 {
+		i -= len(m.BondDenom)
+		copy(dAtA[i:], m.BondDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BondDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BondDenomReweighting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BondDenomReweighting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BondDenomReweighting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Reweighting.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.BondDenom) > 0 {
+		i -= len(m.BondDenom)
+		copy(dAtA[i:], m.BondDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BondDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BondDenomStakingCaps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BondDenomStakingCaps) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BondDenomStakingCaps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Caps.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.BondDenom) > 0 {
+		i -= len(m.BondDenom)
+		copy(dAtA[i:], m.BondDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BondDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BondDenomWeightBounds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BondDenomWeightBounds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BondDenomWeightBounds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Bounds.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.BondDenom) > 0 {
+		i -= len(m.BondDenom)
+		copy(dAtA[i:], m.BondDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BondDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.BondTokenWeights) > 0 {
+		for _, e := range m.BondTokenWeights {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorBondDenoms) > 0 {
+		for _, e := range m.ValidatorBondDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IntermediaryAccountDelegators) > 0 {
+		for _, e := range m.IntermediaryAccountDelegators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DVPairSDKBondTokens) > 0 {
+		for _, e := range m.DVPairSDKBondTokens {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DVPairBondTokens) > 0 {
+		for _, e := range m.DVPairBondTokens {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DVPairUnbondingTokens) > 0 {
+		for _, e := range m.DVPairUnbondingTokens {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BondDenomSunsetHeights) > 0 {
+		for _, e := range m.BondDenomSunsetHeights {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Reweightings) > 0 {
+		for _, e := range m.Reweightings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StakingCaps) > 0 {
+		for _, e := range m.StakingCaps {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WeightBounds) > 0 {
+		for _, e := range m.WeightBounds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.IssuedSDKBondTokens.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SunsetCursors) > 0 {
+		for _, e := range m.SunsetCursors {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BondTokenWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BondDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.BondTokenWeight.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ValidatorBondDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.BondDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *IntermediaryAccountDelegator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IntermediaryAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *DVPairSDKBondTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.SDKBondTokens.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *DVPairBondTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.BondTokens.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *DVPairUnbondingTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.UnbondingTokens.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *BondDenomSunsetHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BondDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *BondDenomSunsetCursor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BondDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.NextDvPair)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *BondDenomReweighting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BondDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Reweighting.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *BondDenomStakingCaps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BondDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Caps.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *BondDenomWeightBounds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BondDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Bounds.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondTokenWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondTokenWeights = append(m.BondTokenWeights, BondTokenWeight{})
+			if err := m.BondTokenWeights[len(m.BondTokenWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorBondDenoms = append(m.ValidatorBondDenoms, ValidatorBondDenom{})
+			if err := m.ValidatorBondDenoms[len(m.ValidatorBondDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntermediaryAccountDelegators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IntermediaryAccountDelegators = append(m.IntermediaryAccountDelegators, IntermediaryAccountDelegator{})
+			if err := m.IntermediaryAccountDelegators[len(m.IntermediaryAccountDelegators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DVPairSDKBondTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DVPairSDKBondTokens = append(m.DVPairSDKBondTokens, DVPairSDKBondTokens{})
+			if err := m.DVPairSDKBondTokens[len(m.DVPairSDKBondTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DVPairBondTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DVPairBondTokens = append(m.DVPairBondTokens, DVPairBondTokens{})
+			if err := m.DVPairBondTokens[len(m.DVPairBondTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DVPairUnbondingTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DVPairUnbondingTokens = append(m.DVPairUnbondingTokens, DVPairUnbondingTokens{})
+			if err := m.DVPairUnbondingTokens[len(m.DVPairUnbondingTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenomSunsetHeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondDenomSunsetHeights = append(m.BondDenomSunsetHeights, BondDenomSunsetHeight{})
+			if err := m.BondDenomSunsetHeights[len(m.BondDenomSunsetHeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reweightings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reweightings = append(m.Reweightings, BondDenomReweighting{})
+			if err := m.Reweightings[len(m.Reweightings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCaps = append(m.StakingCaps, BondDenomStakingCaps{})
+			if err := m.StakingCaps[len(m.StakingCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightBounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WeightBounds = append(m.WeightBounds, BondDenomWeightBounds{})
+			if err := m.WeightBounds[len(m.WeightBounds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedSDKBondTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IssuedSDKBondTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SunsetCursors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SunsetCursors = append(m.SunsetCursors, BondDenomSunsetCursor{})
+			if err := m.SunsetCursors[len(m.SunsetCursors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BondTokenWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BondTokenWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BondTokenWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondTokenWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondTokenWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorBondDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorBondDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorBondDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IntermediaryAccountDelegator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IntermediaryAccountDelegator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IntermediaryAccountDelegator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntermediaryAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IntermediaryAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVPairSDKBondTokens) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVPairSDKBondTokens: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVPairSDKBondTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SDKBondTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SDKBondTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVPairBondTokens) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVPairBondTokens: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVPairBondTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVPairUnbondingTokens) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVPairUnbondingTokens: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVPairUnbondingTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnbondingTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BondDenomSunsetHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BondDenomSunsetHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BondDenomSunsetHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BondDenomSunsetCursor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BondDenomSunsetCursor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BondDenomSunsetCursor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextDvPair", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextDvPair = append(m.NextDvPair[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextDvPair == nil {
+				m.NextDvPair = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BondDenomReweighting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BondDenomReweighting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BondDenomReweighting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reweighting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reweighting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BondDenomStakingCaps) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BondDenomStakingCaps: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BondDenomStakingCaps: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Caps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BondDenomWeightBounds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BondDenomWeightBounds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BondDenomWeightBounds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bounds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
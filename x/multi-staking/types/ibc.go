@@ -0,0 +1,13 @@
+package types
+
+import (
+	"strings"
+
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+)
+
+// IsIBCDenom returns whether a denom is the voucher denom of tokens received
+// over IBC, i.e. ibc/{hash}.
+func IsIBCDenom(denom string) bool {
+	return strings.HasPrefix(denom, ibctransfertypes.DenomPrefix+"/")
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// IntermediaryAccountPrefix starts the address of every intermediary account,
+// so that an intermediary account is told apart from any other address without
+// knowing its DV pair
+var IntermediaryAccountPrefix = []byte("msinterm")
+
+// IntermediaryAccount returns the account that locks the bond tokens and holds
+// the sdk delegation of a (delegator, validator) pair. It is the module
+// address of the DV pair with its first bytes replaced by the
+// IntermediaryAccountPrefix.
+func IntermediaryAccount(delAddr sdk.AccAddress, valAddr sdk.ValAddress) sdk.AccAddress {
+	addr := address.Module(ModuleName, GetDVPairKey(delAddr, valAddr))
+	copy(addr, IntermediaryAccountPrefix)
+	return addr
+}
+
+// IsIntermediaryAccount returns true if the address is the intermediary account
+// of a DV pair, whether or not it has delegated. Module addresses are sha256
+// hashes.
+func IsIntermediaryAccount(addr sdk.AccAddress) bool {
+	return len(addr) == sha256.Size && bytes.HasPrefix(addr, IntermediaryAccountPrefix)
+}
+
+// ConversionReserve returns the account holding the bond tokens paid out by the
+// redelegations converting bond tokens between bond denoms. The converted bond
+// tokens are paid to it in exchange.
+func ConversionReserve() sdk.AccAddress {
+	return address.Module(ModuleName, []byte("conversion_reserve"))
+}
@@ -0,0 +1,153 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName is the name of the multi-staking module
+	ModuleName = "multistaking"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// RouterKey is the msg router key for the multi-staking module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the multi-staking module
+	QuerierRoute = ModuleName
+
+	// MemStoreKey defines the in-memory store key
+	MemStoreKey = "mem_multistaking"
+)
+
+// KVStore keys
+var (
+	BondTokenWeightKey              = []byte{0x00}
+	ValidatorBondDenomKey           = []byte{0x01}
+	IntermediaryAccountDelegatorKey = []byte{0x02}
+	DVPairSDKBondTokenKey           = []byte{0x03}
+	DVPairBondTokenKey              = []byte{0x04}
+	DVPairUnbondingTokensKey        = []byte{0x05}
+	BondDenomSunsetHeightKey        = []byte{0x06}
+	ReweightingKey                  = []byte{0x07}
+	StakingCapsKey                  = []byte{0x08}
+	BondedTokensKey                 = []byte{0x09}
+	WeightBoundsKey                 = []byte{0x0A}
+	BondDenomUsesKey                = []byte{0x0B}
+	SunsetCursorKey                 = []byte{0x0C}
+	IssuedSDKBondTokensKey          = []byte{0x0D}
+)
+
+// MemStore keys
+var (
+	CompletedDelegationsKey   = []byte{0x04}
+	CompletedRedelegationsKey = []byte{0x05}
+)
+
+// GetBondTokenWeightKey returns the key for the weight of a bond denom
+func GetBondTokenWeightKey(denom string) []byte {
+	return append(BondTokenWeightKey, []byte(denom)...)
+}
+
+// GetBondDenomSunsetHeightKey returns the key for the sunset height of a bond denom
+func GetBondDenomSunsetHeightKey(denom string) []byte {
+	return append(BondDenomSunsetHeightKey, []byte(denom)...)
+}
+
+// GetReweightingKey returns the key for the reweighting job of a bond denom
+func GetReweightingKey(denom string) []byte {
+	return append(ReweightingKey, []byte(denom)...)
+}
+
+// GetStakingCapsKey returns the key for the staking caps of a bond denom
+func GetStakingCapsKey(denom string) []byte {
+	return append(StakingCapsKey, []byte(denom)...)
+}
+
+// GetBondedTokensKey returns the key for the bonded tokens of a bond denom
+func GetBondedTokensKey(denom string) []byte {
+	return append(BondedTokensKey, []byte(denom)...)
+}
+
+// GetWeightBoundsKey returns the key for the weight bounds of a bond denom
+func GetWeightBoundsKey(denom string) []byte {
+	return append(WeightBoundsKey, []byte(denom)...)
+}
+
+// GetBondDenomUsesKey returns the key for the number of DV pair records of a bond denom
+func GetBondDenomUsesKey(denom string) []byte {
+	return append(BondDenomUsesKey, []byte(denom)...)
+}
+
+// GetSunsetCursorKey returns the key for the force-undelegation cursor of a sunsetting bond denom
+func GetSunsetCursorKey(denom string) []byte {
+	return append(SunsetCursorKey, []byte(denom)...)
+}
+
+// GetValidatorBondDenomKey returns the key for the bond denom of a validator
+func GetValidatorBondDenomKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorBondDenomKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetIntermediaryAccountDelegatorKey returns the key for the delegator of an intermediary account
+func GetIntermediaryAccountDelegatorKey(intermediaryAccount sdk.AccAddress) []byte {
+	return append(IntermediaryAccountDelegatorKey, address.MustLengthPrefix(intermediaryAccount)...)
+}
+
+// GetDVPairKey returns the (delegator, validator) pair part of a DV pair key
+func GetDVPairKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(address.MustLengthPrefix(delAddr), address.MustLengthPrefix(valAddr)...)
+}
+
+// GetDelegatorDVPairsPrefix returns the prefix of the DV pair keys of a delegator
+func GetDelegatorDVPairsPrefix(delAddr sdk.AccAddress) []byte {
+	return address.MustLengthPrefix(delAddr)
+}
+
+// GetDVPairSDKBondTokenKey returns the key for the sdkbond tokens of a DV pair
+func GetDVPairSDKBondTokenKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(DVPairSDKBondTokenKey, GetDVPairKey(delAddr, valAddr)...)
+}
+
+// GetDVPairBondTokenKey returns the key for the bond tokens of a DV pair
+func GetDVPairBondTokenKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(DVPairBondTokenKey, GetDVPairKey(delAddr, valAddr)...)
+}
+
+// GetDVPairUnbondingTokensPrefix returns the prefix for the unbonding tokens of a DV pair
+func GetDVPairUnbondingTokensPrefix(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(DVPairUnbondingTokensKey, GetDVPairKey(delAddr, valAddr)...)
+}
+
+// GetDVPairUnbondingTokensKey returns the key for the tokens of a DV pair
+// unbonding until the given completion time
+func GetDVPairUnbondingTokensKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress, completionTime time.Time) []byte {
+	return append(GetDVPairUnbondingTokensPrefix(delAddr, valAddr), sdk.FormatTimeBytes(completionTime)...)
+}
+
+// ParseDVPairKey splits a DV pair key, without its store prefix, into the
+// delegator and validator addresses
+func ParseDVPairKey(key []byte) (sdk.AccAddress, sdk.ValAddress) {
+	delLen := int(key[0])
+	delAddr := sdk.AccAddress(key[1 : 1+delLen])
+	valLen := int(key[1+delLen])
+	valAddr := sdk.ValAddress(key[2+delLen : 2+delLen+valLen])
+	return delAddr, valAddr
+}
+
+// ParseDVPairUnbondingTokensKey splits a DV pair unbonding tokens key, without
+// its store prefix, into the delegator and validator addresses and the
+// completion time
+func ParseDVPairUnbondingTokensKey(key []byte) (sdk.AccAddress, sdk.ValAddress, time.Time) {
+	delAddr, valAddr := ParseDVPairKey(key)
+	dvPairLen := 2 + len(delAddr) + len(valAddr)
+	completionTime, err := sdk.ParseTimeBytes(key[dvPairLen:])
+	if err != nil {
+		panic(err)
+	}
+	return delAddr, valAddr, completionTime
+}
@@ -0,0 +1,545 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// multi-staking message types
+const (
+	TypeMsgCreateValidator = "create_validator"
+	TypeMsgDelegate        = "delegate"
+	TypeMsgUndelegate      = "begin_unbonding"
+	TypeMsgBeginRedelegate = "begin_redelegate"
+	TypeMsgCancelUnbond    = "cancel_unbond"
+
+	TypeMsgWithdrawDelegatorReward = "withdraw_delegator_reward"
+	TypeMsgVote                    = "vote"
+	TypeMsgVoteWeighted            = "weighted_vote"
+	TypeMsgUnjail                  = "unjail"
+	TypeMsgFundConversionReserve   = "fund_conversion_reserve"
+)
+
+var (
+	_ sdk.Msg                            = &MsgCreateValidator{}
+	_ codectypes.UnpackInterfacesMessage = (*MsgCreateValidator)(nil)
+	_ sdk.Msg                            = &MsgDelegate{}
+	_ sdk.Msg                            = &MsgUndelegate{}
+	_ sdk.Msg                            = &MsgBeginRedelegate{}
+	_ sdk.Msg                            = &MsgCancelUnbondingDelegation{}
+	_ sdk.Msg                            = &MsgWithdrawDelegatorReward{}
+	_ sdk.Msg                            = &MsgVote{}
+	_ sdk.Msg                            = &MsgVoteWeighted{}
+	_ sdk.Msg                            = &MsgUnjail{}
+	_ sdk.Msg                            = &MsgFundConversionReserve{}
+)
+
+// NewMsgCreateValidator creates a new MsgCreateValidator instance.
+// Delegator address and validator address are the same.
+func NewMsgCreateValidator(
+	valAddr sdk.ValAddress, pubKey cryptotypes.PubKey, //nolint:interfacer
+	selfDelegation sdk.Coin, description stakingtypes.Description, commission stakingtypes.CommissionRates,
+	minSelfDelegation math.Int, bondDenom string,
+) (*MsgCreateValidator, error) {
+	var pkAny *codectypes.Any
+	if pubKey != nil {
+		var err error
+		if pkAny, err = codectypes.NewAnyWithValue(pubKey); err != nil {
+			return nil, err
+		}
+	}
+	return &MsgCreateValidator{
+		Description:       description,
+		DelegatorAddress:  sdk.AccAddress(valAddr).String(),
+		ValidatorAddress:  valAddr.String(),
+		Pubkey:            pkAny,
+		Value:             selfDelegation,
+		Commission:        commission,
+		MinSelfDelegation: minSelfDelegation,
+		BondDenom:         bondDenom,
+	}, nil
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCreateValidator) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCreateValidator) Type() string { return TypeMsgCreateValidator }
+
+// GetSigners implements the sdk.Msg interface. It returns the address(es) that
+// must sign over msg.GetSignBytes().
+// If the validator address is not same as delegator's, then the validator must
+// sign the msg as well.
+func (msg MsgCreateValidator) GetSigners() []sdk.AccAddress {
+	// delegator is first signer so delegator pays fees
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	addrs := []sdk.AccAddress{delegator}
+	valAddr, _ := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+
+	valAccAddr := sdk.AccAddress(valAddr)
+	if !delegator.Equals(valAccAddr) {
+		addrs = append(addrs, valAccAddr)
+	}
+
+	return addrs
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgCreateValidator) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCreateValidator) ValidateBasic() error {
+	// note that unmarshaling from bech32 ensures both non-empty and valid
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+	if !sdk.AccAddress(valAddr).Equals(delAddr) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "validator address is invalid")
+	}
+
+	if msg.Pubkey == nil {
+		return stakingtypes.ErrEmptyValidatorPubKey
+	}
+
+	if err := sdk.ValidateDenom(msg.BondDenom); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if !msg.Value.IsValid() || !msg.Value.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid delegation amount")
+	}
+
+	if msg.Value.Denom != msg.BondDenom {
+		return sdkerrors.Wrapf(ErrMismatchedValidatorBondDenom, "got %s, expected %s", msg.Value.Denom, msg.BondDenom)
+	}
+
+	if msg.Description == (stakingtypes.Description{}) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty description")
+	}
+
+	if msg.Commission == (stakingtypes.CommissionRates{}) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty commission")
+	}
+
+	if err := msg.Commission.Validate(); err != nil {
+		return err
+	}
+
+	if !msg.MinSelfDelegation.IsPositive() {
+		return sdkerrors.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"minimum self delegation must be a positive integer",
+		)
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgCreateValidator) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.Pubkey, &pubKey)
+}
+
+// NewMsgDelegate creates a new MsgDelegate instance.
+func NewMsgDelegate(delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) *MsgDelegate {
+	return &MsgDelegate{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Amount:           amount,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgDelegate) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgDelegate) Type() string { return TypeMsgDelegate }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgDelegate) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgDelegate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgDelegate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return sdkerrors.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid delegation amount",
+		)
+	}
+
+	return nil
+}
+
+// NewMsgUndelegate creates a new MsgUndelegate instance.
+func NewMsgUndelegate(delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) *MsgUndelegate {
+	return &MsgUndelegate{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Amount:           amount,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUndelegate) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUndelegate) Type() string { return TypeMsgUndelegate }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgUndelegate) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgUndelegate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUndelegate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return sdkerrors.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid shares amount",
+		)
+	}
+
+	return nil
+}
+
+// NewMsgBeginRedelegate creates a new MsgBeginRedelegate instance.
+func NewMsgBeginRedelegate(
+	delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, amount sdk.Coin, convert bool,
+) *MsgBeginRedelegate {
+	return &MsgBeginRedelegate{
+		DelegatorAddress:    delAddr.String(),
+		ValidatorSrcAddress: valSrcAddr.String(),
+		ValidatorDstAddress: valDstAddr.String(),
+		Amount:              amount,
+		Convert:             convert,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgBeginRedelegate) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgBeginRedelegate) Type() string { return TypeMsgBeginRedelegate }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgBeginRedelegate) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgBeginRedelegate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgBeginRedelegate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorSrcAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid source validator address: %s", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorDstAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid destination validator address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return sdkerrors.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid redelegation amount",
+		)
+	}
+
+	return nil
+}
+
+// NewMsgCancelUnbondingDelegation creates a new MsgCancelUnbondingDelegation instance.
+func NewMsgCancelUnbondingDelegation(
+	delAddr sdk.AccAddress, valAddr sdk.ValAddress, creationHeight int64, amount sdk.Coin,
+) *MsgCancelUnbondingDelegation {
+	return &MsgCancelUnbondingDelegation{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Amount:           amount,
+		CreationHeight:   creationHeight,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCancelUnbondingDelegation) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCancelUnbondingDelegation) Type() string { return TypeMsgCancelUnbond }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgCancelUnbondingDelegation) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgCancelUnbondingDelegation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCancelUnbondingDelegation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return sdkerrors.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid amount",
+		)
+	}
+
+	if msg.CreationHeight <= 0 {
+		return sdkerrors.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid height",
+		)
+	}
+
+	return nil
+}
+
+// NewMsgWithdrawDelegatorReward creates a new MsgWithdrawDelegatorReward instance.
+func NewMsgWithdrawDelegatorReward(delAddr sdk.AccAddress, valAddr sdk.ValAddress) *MsgWithdrawDelegatorReward {
+	return &MsgWithdrawDelegatorReward{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgWithdrawDelegatorReward) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgWithdrawDelegatorReward) Type() string { return TypeMsgWithdrawDelegatorReward }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgWithdrawDelegatorReward) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgWithdrawDelegatorReward) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgWithdrawDelegatorReward) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	return nil
+}
+
+// NewMsgVote creates a new MsgVote instance.
+func NewMsgVote(voter sdk.AccAddress, proposalID uint64, option govv1.VoteOption, metadata string) *MsgVote {
+	return &MsgVote{
+		ProposalId: proposalID,
+		Voter:      voter.String(),
+		Option:     option,
+		Metadata:   metadata,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgVote) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgVote) Type() string { return TypeMsgVote }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgVote) GetSigners() []sdk.AccAddress {
+	voter, _ := sdk.AccAddressFromBech32(msg.Voter)
+	return []sdk.AccAddress{voter}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgVote) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface. The vote is validated as a
+// gov vote.
+func (msg MsgVote) ValidateBasic() error {
+	return govv1.MsgVote{
+		ProposalId: msg.ProposalId,
+		Voter:      msg.Voter,
+		Option:     msg.Option,
+		Metadata:   msg.Metadata,
+	}.ValidateBasic()
+}
+
+// NewMsgVoteWeighted creates a new MsgVoteWeighted instance.
+func NewMsgVoteWeighted(voter sdk.AccAddress, proposalID uint64, options govv1.WeightedVoteOptions, metadata string) *MsgVoteWeighted {
+	return &MsgVoteWeighted{
+		ProposalId: proposalID,
+		Voter:      voter.String(),
+		Options:    options,
+		Metadata:   metadata,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgVoteWeighted) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgVoteWeighted) Type() string { return TypeMsgVoteWeighted }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
+	voter, _ := sdk.AccAddressFromBech32(msg.Voter)
+	return []sdk.AccAddress{voter}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgVoteWeighted) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface. The vote is validated as a
+// gov weighted vote.
+func (msg MsgVoteWeighted) ValidateBasic() error {
+	return govv1.MsgVoteWeighted{
+		ProposalId: msg.ProposalId,
+		Voter:      msg.Voter,
+		Options:    msg.Options,
+		Metadata:   msg.Metadata,
+	}.ValidateBasic()
+}
+
+// NewMsgUnjail creates a new MsgUnjail instance.
+func NewMsgUnjail(valAddr sdk.ValAddress) *MsgUnjail {
+	return &MsgUnjail{
+		ValidatorAddr: valAddr.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUnjail) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUnjail) Type() string { return TypeMsgUnjail }
+
+// GetSigners implements the sdk.Msg interface. The operator of the validator
+// signs the msg.
+func (msg MsgUnjail) GetSigners() []sdk.AccAddress {
+	valAddr, _ := sdk.ValAddressFromBech32(msg.ValidatorAddr)
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgUnjail) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUnjail) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddr); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	return nil
+}
+
+// NewMsgFundConversionReserve creates a new MsgFundConversionReserve instance.
+func NewMsgFundConversionReserve(depositor sdk.AccAddress, amount sdk.Coins) *MsgFundConversionReserve {
+	return &MsgFundConversionReserve{
+		Depositor: depositor.String(),
+		Amount:    amount,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgFundConversionReserve) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgFundConversionReserve) Type() string { return TypeMsgFundConversionReserve }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgFundConversionReserve) GetSigners() []sdk.AccAddress {
+	depositor, _ := sdk.AccAddressFromBech32(msg.Depositor)
+	return []sdk.AccAddress{depositor}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgFundConversionReserve) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgFundConversionReserve) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid depositor address: %s", err)
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkerrors.ErrInvalidCoins.Wrap(msg.Amount.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,2699 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: multistaking/v1/multi_staking.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// UnbondingTokens defines the bond tokens locked for the sdk unbonding
+// delegation entries created at one height for a (delegator, validator) pair,
+// and the sdkbond tokens unbonded for them.
+type UnbondingTokens struct {
+	BondTokens    types.Coin `protobuf:"bytes,1,opt,name=bond_tokens,json=bondTokens,proto3" json:"bond_tokens"`
+	SDKBondTokens types.Coin `protobuf:"bytes,2,opt,name=sdk_bond_tokens,json=sdkBondTokens,proto3" json:"sdk_bond_tokens"`
+}
+
+func (m *UnbondingTokens) Reset()         { *m = UnbondingTokens{} }
+func (m *UnbondingTokens) String() string { return proto.CompactTextString(m) }
+func (*UnbondingTokens) ProtoMessage()    {}
+func (*UnbondingTokens) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2c118bafa9b671a, []int{0}
+}
+func (m *UnbondingTokens) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingTokens) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingTokens.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnbondingTokens) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingTokens.Merge(m, src)
+}
+func (m *UnbondingTokens) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingTokens) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingTokens.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbondingTokens proto.InternalMessageInfo
+
+func (m *UnbondingTokens) GetBondTokens() types.Coin {
+	if m != nil {
+		return m.BondTokens
+	}
+	return types.Coin{}
+}
+
+func (m *UnbondingTokens) GetSDKBondTokens() types.Coin {
+	if m != nil {
+		return m.SDKBondTokens
+	}
+	return types.Coin{}
+}
+
+// CompletedDelegation defines the sdkbond tokens returned to the intermediary
+// account of a (delegator, validator) pair by the matured sdk unbonding
+// delegation entries with the same completion time.
+type CompletedDelegation struct {
+	DelegatorAddress string     `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string     `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	CompletionTime   time.Time  `protobuf:"bytes,3,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+	Amount           types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *CompletedDelegation) Reset()         { *m = CompletedDelegation{} }
+func (m *CompletedDelegation) String() string { return proto.CompactTextString(m) }
+func (*CompletedDelegation) ProtoMessage()    {}
+func (*CompletedDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2c118bafa9b671a, []int{1}
+}
+func (m *CompletedDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompletedDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompletedDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompletedDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompletedDelegation.Merge(m, src)
+}
+func (m *CompletedDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompletedDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompletedDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompletedDelegation proto.InternalMessageInfo
+
+// CompletedDelegations defines the list of completed delegations kept in the
+// memory store between BeginBlock and EndBlock.
+type CompletedDelegations struct {
+	Delegations []CompletedDelegation `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations"`
+}
+
+func (m *CompletedDelegations) Reset()         { *m = CompletedDelegations{} }
+func (m *CompletedDelegations) String() string { return proto.CompactTextString(m) }
+func (*CompletedDelegations) ProtoMessage()    {}
+func (*CompletedDelegations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2c118bafa9b671a, []int{2}
+}
+func (m *CompletedDelegations) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompletedDelegations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompletedDelegations.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompletedDelegations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompletedDelegations.Merge(m, src)
+}
+func (m *CompletedDelegations) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompletedDelegations) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompletedDelegations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompletedDelegations proto.InternalMessageInfo
+
+func (m *CompletedDelegations) GetDelegations() []CompletedDelegation {
+	if m != nil {
+		return m.Delegations
+	}
+	return nil
+}
+
+// CompletedRedelegation defines the sdkbond tokens of the matured sdk
+// redelegation entries of the intermediary account of a (delegator,
+// destination validator) pair, with the bond denoms of both validators.
+type CompletedRedelegation struct {
+	DelegatorAddress    string     `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorSrcAddress string     `protobuf:"bytes,2,opt,name=validator_src_address,json=validatorSrcAddress,proto3" json:"validator_src_address,omitempty"`
+	ValidatorDstAddress string     `protobuf:"bytes,3,opt,name=validator_dst_address,json=validatorDstAddress,proto3" json:"validator_dst_address,omitempty"`
+	Amount              types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	SrcBondDenom        string     `protobuf:"bytes,5,opt,name=src_bond_denom,json=srcBondDenom,proto3" json:"src_bond_denom,omitempty"`
+	DstBondDenom        string     `protobuf:"bytes,6,opt,name=dst_bond_denom,json=dstBondDenom,proto3" json:"dst_bond_denom,omitempty"`
+}
+
+func (m *CompletedRedelegation) Reset()         { *m = CompletedRedelegation{} }
+func (m *CompletedRedelegation) String() string { return proto.CompactTextString(m) }
+func (*CompletedRedelegation) ProtoMessage()    {}
+func (*CompletedRedelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2c118bafa9b671a, []int{3}
+}
+func (m *CompletedRedelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompletedRedelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompletedRedelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompletedRedelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompletedRedelegation.Merge(m, src)
+}
+func (m *CompletedRedelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompletedRedelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompletedRedelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompletedRedelegation proto.InternalMessageInfo
+
+// CompletedRedelegations defines the list of completed redelegations kept in
+// the memory store between BeginBlock and EndBlock.
+type CompletedRedelegations struct {
+	Redelegations []CompletedRedelegation `protobuf:"bytes,1,rep,name=redelegations,proto3" json:"redelegations"`
+}
+
+func (m *CompletedRedelegations) Reset()         { *m = CompletedRedelegations{} }
+func (m *CompletedRedelegations) String() string { return proto.CompactTextString(m) }
+func (*CompletedRedelegations) ProtoMessage()    {}
+func (*CompletedRedelegations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2c118bafa9b671a, []int{4}
+}
+func (m *CompletedRedelegations) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompletedRedelegations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompletedRedelegations.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompletedRedelegations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompletedRedelegations.Merge(m, src)
+}
+func (m *CompletedRedelegations) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompletedRedelegations) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompletedRedelegations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompletedRedelegations proto.InternalMessageInfo
+
+func (m *CompletedRedelegations) GetRedelegations() []CompletedRedelegation {
+	if m != nil {
+		return m.Redelegations
+	}
+	return nil
+}
+
+// Params defines the parameters for the multi-staking module.
+type Params struct {
+	// unbonding_remainder_recipient is the address receiving the bond tokens
+	// backing the sdkbond tokens lost to slashing while they were delegated or
+	// unbonding. Those bond tokens are burned if it is empty.
+	UnbondingRemainderRecipient string `protobuf:"bytes,1,opt,name=unbonding_remainder_recipient,json=unbondingRemainderRecipient,proto3" json:"unbonding_remainder_recipient,omitempty"`
+	// reweighting_batch_size is the maximum number of DV pairs scanned by the
+	// reweighting jobs in a block.
+	ReweightingBatchSize uint32 `protobuf:"varint,2,opt,name=reweighting_batch_size,json=reweightingBatchSize,proto3" json:"reweighting_batch_size,omitempty"`
+	// weight_epoch_length is the number of blocks between two updates of the
+	// dynamic bond token weights from the weight provider.
+	WeightEpochLength uint64 `protobuf:"varint,3,opt,name=weight_epoch_length,json=weightEpochLength,proto3" json:"weight_epoch_length,omitempty"`
+	// sunset_batch_size is the maximum number of DV pairs scanned in a block to
+	// force-undelegate the delegations of the sunsetting bond denoms.
+	SunsetBatchSize uint32 `protobuf:"varint,4,opt,name=sunset_batch_size,json=sunsetBatchSize,proto3" json:"sunset_batch_size,omitempty"`
+	// sdk_bond_transferable lets users transfer the sdkbond tokens they hold,
+	// such as the staking rewards of a chain minting the sdkbond denom. The
+	// sdkbond tokens held by the intermediary accounts are never transferable.
+	SdkBondTransferable bool `protobuf:"varint,5,opt,name=sdk_bond_transferable,json=sdkBondTransferable,proto3" json:"sdk_bond_transferable,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2c118bafa9b671a, []int{5}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetUnbondingRemainderRecipient() string {
+	if m != nil {
+		return m.UnbondingRemainderRecipient
+	}
+	return ""
+}
+
+func (m *Params) GetReweightingBatchSize() uint32 {
+	if m != nil {
+		return m.ReweightingBatchSize
+	}
+	return 0
+}
+
+func (m *Params) GetWeightEpochLength() uint64 {
+	if m != nil {
+		return m.WeightEpochLength
+	}
+	return 0
+}
+
+func (m *Params) GetSunsetBatchSize() uint32 {
+	if m != nil {
+		return m.SunsetBatchSize
+	}
+	return 0
+}
+
+func (m *Params) GetSdkBondTransferable() bool {
+	if m != nil {
+		return m.SdkBondTransferable
+	}
+	return false
+}
+
+// Reweighting is the progress of the job bringing the sdkbond tokens of the
+// DV pairs of a bond denom in line with a new bond token weight.
+type Reweighting struct {
+	// bond_token_weight is the weight the DV pairs are reweighted to.
+	BondTokenWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=bond_token_weight,json=bondTokenWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bond_token_weight"`
+	// next_dv_pair is the DV pair key the next batch starts from.
+	NextDvPair []byte `protobuf:"bytes,2,opt,name=next_dv_pair,json=nextDvPair,proto3" json:"next_dv_pair,omitempty"`
+	// retry is set when some DV pairs could not be reweighted during the
+	// current pass over the DV pairs, so that another pass is made.
+	Retry bool `protobuf:"varint,3,opt,name=retry,proto3" json:"retry,omitempty"`
+	// stop_dv_pair is set when the weight changed during a pass over the DV
+	// pairs, to the DV pair key the pass was at. The pass then wraps around to
+	// the first DV pair and ends at this key, so that the DV pairs already
+	// scanned are reweighted to the new weight without restarting the pass.
+	StopDvPair []byte `protobuf:"bytes,4,opt,name=stop_dv_pair,json=stopDvPair,proto3" json:"stop_dv_pair,omitempty"`
+	// retries is the number of consecutive passes which left DV pairs to retry.
+	Retries uint32 `protobuf:"varint,5,opt,name=retries,proto3" json:"retries,omitempty"`
+	// next_pass_height is the block height the next pass starts at, which is
+	// delayed after a pass leaving DV pairs to retry.
+	NextPassHeight int64 `protobuf:"varint,6,opt,name=next_pass_height,json=nextPassHeight,proto3" json:"next_pass_height,omitempty"`
+}
+
+func (m *Reweighting) Reset()         { *m = Reweighting{} }
+func (m *Reweighting) String() string { return proto.CompactTextString(m) }
+func (*Reweighting) ProtoMessage()    {}
+func (*Reweighting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2c118bafa9b671a, []int{6}
+}
+func (m *Reweighting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Reweighting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Reweighting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Reweighting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Reweighting.Merge(m, src)
+}
+func (m *Reweighting) XXX_Size() int {
+	return m.Size()
+}
+func (m *Reweighting) XXX_DiscardUnknown() {
+	xxx_messageInfo_Reweighting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Reweighting proto.InternalMessageInfo
+
+func (m *Reweighting) GetNextDvPair() []byte {
+	if m != nil {
+		return m.NextDvPair
+	}
+	return nil
+}
+
+func (m *Reweighting) GetRetry() bool {
+	if m != nil {
+		return m.Retry
+	}
+	return false
+}
+
+func (m *Reweighting) GetStopDvPair() []byte {
+	if m != nil {
+		return m.StopDvPair
+	}
+	return nil
+}
+
+func (m *Reweighting) GetRetries() uint32 {
+	if m != nil {
+		return m.Retries
+	}
+	return 0
+}
+
+func (m *Reweighting) GetNextPassHeight() int64 {
+	if m != nil {
+		return m.NextPassHeight
+	}
+	return 0
+}
+
+// StakingCaps are the limits on the staking of a bond denom, so that a token
+// which is cheap to acquire cannot capture the voting power. A zero cap is not
+// enforced.
+type StakingCaps struct {
+	// max_bonded_tokens is the maximum amount of bond tokens of the bond denom
+	// delegated at once.
+	MaxBondedTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=max_bonded_tokens,json=maxBondedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_bonded_tokens"`
+	// max_power_share is the maximum share of the tokens of all bonded
+	// validators held by the bonded validators pinned to the bond denom.
+	MaxPowerShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_power_share,json=maxPowerShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_power_share"`
+}
+
+func (m *StakingCaps) Reset()         { *m = StakingCaps{} }
+func (m *StakingCaps) String() string { return proto.CompactTextString(m) }
+func (*StakingCaps) ProtoMessage()    {}
+func (*StakingCaps) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2c118bafa9b671a, []int{7}
+}
+func (m *StakingCaps) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakingCaps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakingCaps.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakingCaps) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakingCaps.Merge(m, src)
+}
+func (m *StakingCaps) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakingCaps) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakingCaps.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakingCaps proto.InternalMessageInfo
+
+// WeightBounds are the bounds of the bond token weight of a bond denom whose
+// weight is recomputed every epoch from the weight provider.
+type WeightBounds struct {
+	// min_weight is the minimum bond token weight.
+	MinWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=min_weight,json=minWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_weight"`
+	// max_weight is the maximum bond token weight.
+	MaxWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_weight,json=maxWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_weight"`
+	// max_change_rate is the maximum change of the bond token weight in an
+	// epoch, as a fraction of the weight. A zero rate is not enforced.
+	MaxChangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_change_rate"`
+}
+
+func (m *WeightBounds) Reset()         { *m = WeightBounds{} }
+func (m *WeightBounds) String() string { return proto.CompactTextString(m) }
+func (*WeightBounds) ProtoMessage()    {}
+func (*WeightBounds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2c118bafa9b671a, []int{8}
+}
+func (m *WeightBounds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightBounds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightBounds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightBounds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightBounds.Merge(m, src)
+}
+func (m *WeightBounds) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightBounds) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightBounds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightBounds proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*UnbondingTokens)(nil), "multistaking.v1.UnbondingTokens")
+	proto.RegisterType((*CompletedDelegation)(nil), "multistaking.v1.CompletedDelegation")
+	proto.RegisterType((*CompletedDelegations)(nil), "multistaking.v1.CompletedDelegations")
+	proto.RegisterType((*CompletedRedelegation)(nil), "multistaking.v1.CompletedRedelegation")
+	proto.RegisterType((*CompletedRedelegations)(nil), "multistaking.v1.CompletedRedelegations")
+	proto.RegisterType((*Params)(nil), "multistaking.v1.Params")
+	proto.RegisterType((*Reweighting)(nil), "multistaking.v1.Reweighting")
+	proto.RegisterType((*StakingCaps)(nil), "multistaking.v1.StakingCaps")
+	proto.RegisterType((*WeightBounds)(nil), "multistaking.v1.WeightBounds")
+}
+
+func init() {
+	proto.RegisterFile("multistaking/v1/multi_staking.proto", fileDescriptor_c2c118bafa9b671a)
+}
+
+var fileDescriptor_c2c118bafa9b671a = []byte{
+	// 990 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xf6, 0x47, 0x1a, 0xd2, 0x71, 0x1c, 0x37, 0x9b, 0xa4, 0x72, 0x83, 0xb0, 0x23, 0x53, 0x55,
+	0x11, 0x92, 0xd7, 0x4a, 0x40, 0x42, 0x42, 0x1c, 0xc0, 0x71, 0x25, 0x2a, 0x82, 0x14, 0x8d, 0x8b,
+	0x40, 0x7c, 0x68, 0x35, 0xde, 0x99, 0xae, 0x47, 0xde, 0x9d, 0x59, 0xcd, 0x8c, 0x5d, 0xb7, 0xbf,
+	0x00, 0x6e, 0xbd, 0x70, 0xef, 0x0f, 0x00, 0x4e, 0xe5, 0x3f, 0xf4, 0x58, 0xf5, 0x84, 0x90, 0x08,
+	0x28, 0xb9, 0xf0, 0x33, 0xd0, 0x7c, 0xec, 0x7a, 0x43, 0x83, 0x92, 0x56, 0x39, 0x65, 0xdf, 0x8f,
+	0xe7, 0x79, 0x67, 0x9f, 0x67, 0xf2, 0xae, 0xc1, 0xbb, 0xc9, 0x34, 0x56, 0x54, 0x2a, 0x34, 0xa1,
+	0x2c, 0xea, 0xcd, 0xf6, 0x7a, 0x26, 0x0e, 0x5c, 0xc2, 0x4f, 0x05, 0x57, 0xdc, 0x6b, 0x14, 0x9b,
+	0xfc, 0xd9, 0xde, 0x76, 0x3b, 0xe2, 0x3c, 0x8a, 0x49, 0xcf, 0x94, 0x47, 0xd3, 0x07, 0x3d, 0x45,
+	0x13, 0x22, 0x15, 0x4a, 0x52, 0x8b, 0xd8, 0xde, 0x8c, 0x78, 0xc4, 0xcd, 0x63, 0x4f, 0x3f, 0xb9,
+	0xec, 0xad, 0x90, 0xcb, 0x84, 0xcb, 0xc0, 0x16, 0x6c, 0xe0, 0x4a, 0x2d, 0x1b, 0xf5, 0x46, 0x48,
+	0x92, 0xde, 0x6c, 0x6f, 0x44, 0x14, 0xda, 0xeb, 0x85, 0x9c, 0x32, 0x5b, 0xef, 0xfc, 0x52, 0x06,
+	0x8d, 0x2f, 0xd9, 0x88, 0x33, 0x4c, 0x59, 0x74, 0x9f, 0x4f, 0x08, 0x93, 0xde, 0x27, 0xa0, 0xa6,
+	0x13, 0x81, 0x32, 0x61, 0xb3, 0xbc, 0x53, 0xde, 0xad, 0xed, 0xdf, 0xf2, 0x1d, 0xaf, 0x66, 0xf2,
+	0x1d, 0x93, 0x7f, 0xc0, 0x29, 0xeb, 0x2f, 0x3d, 0x3f, 0x6e, 0x97, 0x20, 0xd0, 0x18, 0xc7, 0xf0,
+	0x35, 0x68, 0x48, 0x3c, 0x09, 0x8a, 0x2c, 0x95, 0x8b, 0x58, 0xb6, 0x34, 0xcb, 0xc9, 0x71, 0xbb,
+	0x3e, 0x1c, 0x7c, 0xde, 0xcf, 0xa9, 0x60, 0x5d, 0xe2, 0xc9, 0x22, 0xec, 0xfc, 0x56, 0x01, 0x1b,
+	0x07, 0x3c, 0x49, 0x63, 0xa2, 0x08, 0x1e, 0x90, 0x98, 0x44, 0x48, 0x51, 0xce, 0xbc, 0xbb, 0x60,
+	0x1d, 0xdb, 0x88, 0x8b, 0x00, 0x61, 0x2c, 0x88, 0xb4, 0x27, 0xbf, 0xde, 0x6f, 0xbe, 0x7c, 0xd6,
+	0xdd, 0x74, 0x63, 0x3f, 0xb5, 0x95, 0xa1, 0x12, 0x94, 0x45, 0xf0, 0x46, 0x0e, 0x71, 0x79, 0x4d,
+	0x33, 0x43, 0x31, 0xc5, 0x67, 0x68, 0x2a, 0x17, 0xd1, 0xe4, 0x90, 0x8c, 0xe6, 0x0b, 0xd0, 0x08,
+	0xed, 0x21, 0x29, 0x67, 0x81, 0x36, 0xb1, 0x59, 0x35, 0xef, 0xbf, 0xed, 0x5b, 0x87, 0xfd, 0xcc,
+	0x61, 0xff, 0x7e, 0xe6, 0x70, 0x7f, 0x45, 0x0b, 0xf0, 0xe4, 0xaf, 0x76, 0x19, 0xae, 0x2d, 0xc0,
+	0xba, 0xec, 0x7d, 0x08, 0x96, 0x51, 0xc2, 0xa7, 0x4c, 0x35, 0x97, 0x2e, 0xe7, 0x85, 0x6b, 0xff,
+	0x68, 0xe5, 0x87, 0xa7, 0xed, 0xd2, 0x3f, 0x4f, 0xdb, 0xa5, 0x0e, 0x06, 0x9b, 0xe7, 0xc8, 0x26,
+	0xbd, 0x43, 0x50, 0xc3, 0x8b, 0xb0, 0x59, 0xde, 0xa9, 0xee, 0xd6, 0xf6, 0x6f, 0xfb, 0xff, 0xb9,
+	0x98, 0xfe, 0x39, 0x58, 0x37, 0xaa, 0x08, 0xef, 0xfc, 0x58, 0x05, 0x5b, 0x79, 0x2b, 0x24, 0xf8,
+	0xca, 0xfd, 0x39, 0x04, 0x5b, 0x0b, 0x7f, 0xa4, 0x08, 0x2f, 0xed, 0xd1, 0x46, 0x0e, 0x1b, 0x8a,
+	0xf0, 0x5c, 0x36, 0x2c, 0x55, 0xce, 0x56, 0xbd, 0x34, 0xdb, 0x40, 0xaa, 0x8c, 0xed, 0x4d, 0x5d,
+	0xf2, 0x6e, 0x83, 0x35, 0xfd, 0x2a, 0xe6, 0xbf, 0x05, 0x13, 0xc6, 0x93, 0xe6, 0x35, 0x3d, 0x1f,
+	0xae, 0x4a, 0x11, 0xea, 0xab, 0x3f, 0xd0, 0x39, 0xdd, 0xa5, 0x8f, 0x58, 0xe8, 0x5a, 0xb6, 0x5d,
+	0x58, 0xaa, 0xbc, 0xab, 0xe0, 0x78, 0x0c, 0x6e, 0x9e, 0x6b, 0x85, 0xf4, 0x20, 0xa8, 0x0b, 0xf2,
+	0xaa, 0xeb, 0x77, 0xfe, 0xdf, 0xf5, 0x22, 0xde, 0x1d, 0xfe, 0x2c, 0x45, 0xe7, 0xe7, 0x0a, 0x58,
+	0x3e, 0x42, 0x02, 0x25, 0xd2, 0xfb, 0x0e, 0xbc, 0x33, 0xcd, 0x36, 0x4a, 0x20, 0x48, 0x82, 0x28,
+	0xc3, 0x44, 0x04, 0x82, 0x84, 0x34, 0xa5, 0x84, 0xa9, 0x0b, 0x6d, 0x7f, 0x3b, 0x87, 0xc3, 0x0c,
+	0x0d, 0x33, 0xb0, 0xf7, 0x01, 0xb8, 0x29, 0xc8, 0x43, 0x42, 0xa3, 0xb1, 0xd2, 0xfc, 0x23, 0xa4,
+	0xc2, 0x71, 0x20, 0xe9, 0x63, 0x62, 0xae, 0x40, 0x1d, 0x6e, 0x16, 0xaa, 0x7d, 0x5d, 0x1c, 0xd2,
+	0xc7, 0xc4, 0xf3, 0xc1, 0x86, 0xcd, 0x06, 0x24, 0xe5, 0xe1, 0x38, 0x88, 0x09, 0x8b, 0xd4, 0xd8,
+	0xf8, 0xbc, 0x04, 0xd7, 0x6d, 0xe9, 0xae, 0xae, 0x1c, 0x9a, 0x82, 0xf7, 0x1e, 0x58, 0x97, 0x53,
+	0x26, 0x89, 0x2a, 0x0e, 0x58, 0x32, 0x03, 0x1a, 0xb6, 0xb0, 0xe0, 0xde, 0x07, 0x5b, 0x8b, 0x65,
+	0x27, 0x10, 0x93, 0x0f, 0x88, 0x40, 0xa3, 0x98, 0x18, 0x17, 0x57, 0xe0, 0x46, 0xb6, 0xc0, 0x0a,
+	0xa5, 0xce, 0x4f, 0x15, 0x50, 0x83, 0x8b, 0x83, 0x7a, 0x63, 0xb0, 0xbe, 0x58, 0x96, 0x81, 0xcd,
+	0x3b, 0x9d, 0x3e, 0xd6, 0x72, 0xff, 0x71, 0xdc, 0xbe, 0x13, 0x51, 0x35, 0x9e, 0x8e, 0xfc, 0x90,
+	0x27, 0x6e, 0xc5, 0xbb, 0x3f, 0x5d, 0x89, 0x27, 0x3d, 0xf5, 0x28, 0x25, 0xd2, 0x1f, 0x90, 0xf0,
+	0xe5, 0xb3, 0x2e, 0x70, 0xaa, 0x0e, 0x48, 0x08, 0x1b, 0xf9, 0x56, 0xfe, 0xca, 0x90, 0x7a, 0x3b,
+	0x60, 0x95, 0x91, 0xb9, 0x0a, 0xf0, 0x2c, 0x48, 0x11, 0x15, 0x46, 0xb5, 0x55, 0x08, 0x74, 0x6e,
+	0x30, 0x3b, 0x42, 0x54, 0x78, 0x9b, 0xe0, 0x9a, 0x20, 0x4a, 0x3c, 0x32, 0xea, 0xac, 0x40, 0x1b,
+	0x68, 0x9c, 0x54, 0x3c, 0xcd, 0x71, 0x4b, 0x16, 0xa7, 0x73, 0x0e, 0xd7, 0x04, 0x6f, 0xe9, 0x56,
+	0x4a, 0xa4, 0x79, 0xf3, 0x3a, 0xcc, 0x42, 0x6f, 0x17, 0xdc, 0x30, 0x33, 0x53, 0x24, 0x65, 0x30,
+	0xb6, 0x2f, 0xa7, 0x2f, 0x6f, 0x15, 0xae, 0xe9, 0xfc, 0x11, 0x92, 0xf2, 0x33, 0x93, 0xed, 0xfc,
+	0x59, 0x06, 0xb5, 0xa1, 0xbd, 0x80, 0x07, 0x28, 0x95, 0x5a, 0x97, 0x04, 0xcd, 0x8d, 0xb6, 0xe4,
+	0xcc, 0x07, 0xe9, 0xf5, 0x74, 0xb9, 0xc7, 0x54, 0x41, 0x97, 0x7b, 0x4c, 0xc1, 0x46, 0x82, 0xe6,
+	0x7d, 0xc3, 0xea, 0x3e, 0x59, 0x18, 0xe8, 0x54, 0x90, 0xf2, 0x87, 0x44, 0x04, 0x72, 0x8c, 0x04,
+	0x69, 0x56, 0x5e, 0x7b, 0xce, 0xab, 0xfa, 0xd7, 0x13, 0x34, 0x3f, 0xd2, 0x9c, 0x43, 0x4d, 0xd9,
+	0xf9, 0xb5, 0x02, 0x56, 0xad, 0x11, 0x7d, 0x3e, 0x65, 0x58, 0x7a, 0xdf, 0x02, 0x90, 0xd0, 0x2b,
+	0x75, 0xfc, 0x7a, 0x42, 0x33, 0xaf, 0x35, 0x39, 0x9a, 0x67, 0xe4, 0x95, 0x2b, 0x21, 0x47, 0x73,
+	0x47, 0xee, 0x04, 0x0b, 0xc7, 0x88, 0x45, 0x24, 0x10, 0x48, 0x91, 0x66, 0xf5, 0x0a, 0x26, 0x68,
+	0xc1, 0x0e, 0x0c, 0x27, 0x44, 0x8a, 0xf4, 0xbf, 0x7f, 0x7e, 0xd2, 0x2a, 0xbf, 0x38, 0x69, 0x95,
+	0xff, 0x3e, 0x69, 0x95, 0x9f, 0x9c, 0xb6, 0x4a, 0x2f, 0x4e, 0x5b, 0xa5, 0xdf, 0x4f, 0x5b, 0xa5,
+	0x6f, 0x0e, 0x0a, 0xf4, 0x8c, 0xeb, 0x2d, 0x84, 0xe2, 0x6e, 0x8c, 0x46, 0xd2, 0xfe, 0xd4, 0xea,
+	0xba, 0x3d, 0xd6, 0x4d, 0x38, 0x9e, 0xc6, 0xa4, 0x37, 0x3f, 0x9b, 0xb6, 0xf3, 0x47, 0xcb, 0xe6,
+	0x3b, 0xfc, 0xfe, 0xbf, 0x03, 0x00, 0x3e, 0x89, 0x4a, 0xa2, 0xaf, 0x09, 0x00, 0x00,
+}
+
+func (m *UnbondingTokens) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingTokens) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SDKBondTokens.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMultiStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.BondTokens.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMultiStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CompletedDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompletedDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompletedDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMultiStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintMultiStaking(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintMultiStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintMultiStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompletedDelegations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompletedDelegations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompletedDelegations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMultiStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompletedRedelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompletedRedelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompletedRedelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DstBondDenom) > 0 {
+		i -= len(m.DstBondDenom)
+		copy(dAtA[i:], m.DstBondDenom)
+		i = encodeVarintMultiStaking(dAtA, i, uint64(len(m.DstBondDenom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SrcBondDenom) > 0 {
+		i -= len(m.SrcBondDenom)
+		copy(dAtA[i:], m.SrcBondDenom)
+		i = encodeVarintMultiStaking(dAtA, i, uint64(len(m.SrcBondDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMultiStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ValidatorDstAddress) > 0 {
+		i -= len(m.ValidatorDstAddress)
+		copy(dAtA[i:], m.ValidatorDstAddress)
+		i = encodeVarintMultiStaking(dAtA, i, uint64(len(m.ValidatorDstAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorSrcAddress) > 0 {
+		i -= len(m.ValidatorSrcAddress)
+		copy(dAtA[i:], m.ValidatorSrcAddress)
+		i = encodeVarintMultiStaking(dAtA, i, uint64(len(m.ValidatorSrcAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintMultiStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompletedRedelegations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompletedRedelegations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompletedRedelegations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Redelegations) > 0 {
+		for iNdEx := len(m.Redelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMultiStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SdkBondTransferable {
+		i--
+		if m.SdkBondTransferable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SunsetBatchSize != 0 {
+		i = encodeVarintMultiStaking(dAtA, i, uint64(m.SunsetBatchSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WeightEpochLength != 0 {
+		i = encodeVarintMultiStaking(dAtA, i, uint64(m.WeightEpochLength))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ReweightingBatchSize != 0 {
+		i = encodeVarintMultiStaking(dAtA, i, uint64(m.ReweightingBatchSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.UnbondingRemainderRecipient) > 0 {
+		i -= len(m.UnbondingRemainderRecipient)
+		copy(dAtA[i:], m.UnbondingRemainderRecipient)
+		i = encodeVarintMultiStaking(dAtA, i, uint64(len(m.UnbondingRemainderRecipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Reweighting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Reweighting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Reweighting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextPassHeight != 0 {
+		i = encodeVarintMultiStaking(dAtA, i, uint64(m.NextPassHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Retries != 0 {
+		i = encodeVarintMultiStaking(dAtA, i, uint64(m.Retries))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.StopDvPair) > 0 {
+		i -= len(m.StopDvPair)
+		copy(dAtA[i:], m.StopDvPair)
+		i = encodeVarintMultiStaking(dAtA, i, uint64(len(m.StopDvPair)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Retry {
+		i--
+		if m.Retry {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NextDvPair) > 0 {
+		i -= len(m.NextDvPair)
+		copy(dAtA[i:], m.NextDvPair)
+		i = encodeVarintMultiStaking(dAtA, i, uint64(len(m.NextDvPair)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.BondTokenWeight.Size()
+		i -= size
+		if _, err := m.BondTokenWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMultiStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *StakingCaps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakingCaps) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakingCaps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxPowerShare.Size()
+		i -= size
+		if _, err := m.MaxPowerShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMultiStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxBondedTokens.Size()
+		i -= size
+		if _, err := m.MaxBondedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMultiStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *WeightBounds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightBounds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightBounds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxChangeRate.Size()
+		i -= size
+		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMultiStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxWeight.Size()
+		i -= size
+		if _, err := m.MaxWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMultiStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MinWeight.Size()
+		i -= size
+		if _, err := m.MinWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMultiStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMultiStaking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMultiStaking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UnbondingTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BondTokens.Size()
+	n += 1 + l + sovMultiStaking(uint64(l))
+	l = m.SDKBondTokens.Size()
+	n += 1 + l + sovMultiStaking(uint64(l))
+	return n
+}
+
+func (m *CompletedDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMultiStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMultiStaking(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovMultiStaking(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovMultiStaking(uint64(l))
+	return n
+}
+
+func (m *CompletedDelegations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovMultiStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CompletedRedelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMultiStaking(uint64(l))
+	}
+	l = len(m.ValidatorSrcAddress)
+	if l > 0 {
+		n += 1 + l + sovMultiStaking(uint64(l))
+	}
+	l = len(m.ValidatorDstAddress)
+	if l > 0 {
+		n += 1 + l + sovMultiStaking(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMultiStaking(uint64(l))
+	l = len(m.SrcBondDenom)
+	if l > 0 {
+		n += 1 + l + sovMultiStaking(uint64(l))
+	}
+	l = len(m.DstBondDenom)
+	if l > 0 {
+		n += 1 + l + sovMultiStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *CompletedRedelegations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Redelegations) > 0 {
+		for _, e := range m.Redelegations {
+			l = e.Size()
+			n += 1 + l + sovMultiStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UnbondingRemainderRecipient)
+	if l > 0 {
+		n += 1 + l + sovMultiStaking(uint64(l))
+	}
+	if m.ReweightingBatchSize != 0 {
+		n += 1 + sovMultiStaking(uint64(m.ReweightingBatchSize))
+	}
+	if m.WeightEpochLength != 0 {
+		n += 1 + sovMultiStaking(uint64(m.WeightEpochLength))
+	}
+	if m.SunsetBatchSize != 0 {
+		n += 1 + sovMultiStaking(uint64(m.SunsetBatchSize))
+	}
+	if m.SdkBondTransferable {
+		n += 2
+	}
+	return n
+}
+
+func (m *Reweighting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BondTokenWeight.Size()
+	n += 1 + l + sovMultiStaking(uint64(l))
+	l = len(m.NextDvPair)
+	if l > 0 {
+		n += 1 + l + sovMultiStaking(uint64(l))
+	}
+	if m.Retry {
+		n += 2
+	}
+	l = len(m.StopDvPair)
+	if l > 0 {
+		n += 1 + l + sovMultiStaking(uint64(l))
+	}
+	if m.Retries != 0 {
+		n += 1 + sovMultiStaking(uint64(m.Retries))
+	}
+	if m.NextPassHeight != 0 {
+		n += 1 + sovMultiStaking(uint64(m.NextPassHeight))
+	}
+	return n
+}
+
+func (m *StakingCaps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxBondedTokens.Size()
+	n += 1 + l + sovMultiStaking(uint64(l))
+	l = m.MaxPowerShare.Size()
+	n += 1 + l + sovMultiStaking(uint64(l))
+	return n
+}
+
+func (m *WeightBounds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinWeight.Size()
+	n += 1 + l + sovMultiStaking(uint64(l))
+	l = m.MaxWeight.Size()
+	n += 1 + l + sovMultiStaking(uint64(l))
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovMultiStaking(uint64(l))
+	return n
+}
+
+func sovMultiStaking(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMultiStaking(x uint64) (n int) {
+	return sovMultiStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UnbondingTokens) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultiStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingTokens: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultiStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SDKBondTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultiStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SDKBondTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultiStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompletedDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultiStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompletedDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompletedDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultiStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultiStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultiStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultiStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultiStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompletedDelegations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultiStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompletedDelegations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompletedDelegations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultiStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, CompletedDelegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultiStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompletedRedelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultiStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompletedRedelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompletedRedelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultiStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultiStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultiStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultiStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcBondDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultiStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcBondDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstBondDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultiStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstBondDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultiStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompletedRedelegations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultiStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompletedRedelegations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompletedRedelegations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultiStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redelegations = append(m.Redelegations, CompletedRedelegation{})
+			if err := m.Redelegations[len(m.Redelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultiStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultiStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingRemainderRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultiStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingRemainderRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReweightingBatchSize", wireType)
+			}
+			m.ReweightingBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultiStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReweightingBatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightEpochLength", wireType)
+			}
+			m.WeightEpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultiStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WeightEpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SunsetBatchSize", wireType)
+			}
+			m.SunsetBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultiStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SunsetBatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SdkBondTransferable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultiStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SdkBondTransferable = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultiStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Reweighting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultiStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Reweighting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Reweighting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondTokenWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultiStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondTokenWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextDvPair", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultiStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextDvPair = append(m.NextDvPair[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextDvPair == nil {
+				m.NextDvPair = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultiStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Retry = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopDvPair", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultiStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StopDvPair = append(m.StopDvPair[:0], dAtA[iNdEx:postIndex]...)
+			if m.StopDvPair == nil {
+				m.StopDvPair = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			m.Retries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultiStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPassHeight", wireType)
+			}
+			m.NextPassHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultiStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextPassHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultiStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakingCaps) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultiStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakingCaps: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakingCaps: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBondedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultiStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPowerShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultiStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPowerShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultiStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightBounds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultiStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightBounds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightBounds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultiStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultiStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultiStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultiStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultiStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMultiStaking(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMultiStaking
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMultiStaking
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMultiStaking
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMultiStaking
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMultiStaking
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMultiStaking
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMultiStaking        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMultiStaking          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMultiStaking = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,159 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Default parameter values
+const (
+	// DefaultUnbondingRemainderRecipient burns the unbonding remainders
+	DefaultUnbondingRemainderRecipient = ""
+
+	// DefaultReweightingBatchSize is the default number of DV pairs scanned
+	// by the reweighting jobs in a block
+	DefaultReweightingBatchSize uint32 = 100
+
+	// DefaultWeightEpochLength is the default number of blocks between two
+	// updates of the dynamic bond token weights, about a day of 6s blocks
+	DefaultWeightEpochLength uint64 = 14400
+
+	// DefaultSunsetBatchSize is the default number of DV pairs scanned to
+	// force-undelegate the sunsetting bond denoms in a block
+	DefaultSunsetBatchSize uint32 = 100
+
+	// DefaultSDKBondTransferable keeps the sdkbond tokens non-transferable
+	DefaultSDKBondTransferable = false
+)
+
+// Parameter store keys
+var (
+	KeyUnbondingRemainderRecipient = []byte("UnbondingRemainderRecipient")
+	KeyReweightingBatchSize        = []byte("ReweightingBatchSize")
+	KeyWeightEpochLength           = []byte("WeightEpochLength")
+	KeySunsetBatchSize             = []byte("SunsetBatchSize")
+	KeySDKBondTransferable         = []byte("SDKBondTransferable")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable returns the parameter key table for the multi-staking module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(
+	unbondingRemainderRecipient string, reweightingBatchSize uint32, weightEpochLength uint64, sunsetBatchSize uint32,
+	sdkBondTransferable bool,
+) Params {
+	return Params{
+		UnbondingRemainderRecipient: unbondingRemainderRecipient,
+		ReweightingBatchSize:        reweightingBatchSize,
+		WeightEpochLength:           weightEpochLength,
+		SunsetBatchSize:             sunsetBatchSize,
+		SdkBondTransferable:         sdkBondTransferable,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(
+		DefaultUnbondingRemainderRecipient, DefaultReweightingBatchSize, DefaultWeightEpochLength, DefaultSunsetBatchSize,
+		DefaultSDKBondTransferable,
+	)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyUnbondingRemainderRecipient, &p.UnbondingRemainderRecipient, validateUnbondingRemainderRecipient),
+		paramtypes.NewParamSetPair(KeyReweightingBatchSize, &p.ReweightingBatchSize, validateReweightingBatchSize),
+		paramtypes.NewParamSetPair(KeyWeightEpochLength, &p.WeightEpochLength, validateWeightEpochLength),
+		paramtypes.NewParamSetPair(KeySunsetBatchSize, &p.SunsetBatchSize, validateSunsetBatchSize),
+		paramtypes.NewParamSetPair(KeySDKBondTransferable, &p.SdkBondTransferable, validateSDKBondTransferable),
+	}
+}
+
+// Validate validates a set of params
+func (p Params) Validate() error {
+	if err := validateUnbondingRemainderRecipient(p.UnbondingRemainderRecipient); err != nil {
+		return err
+	}
+	if err := validateReweightingBatchSize(p.ReweightingBatchSize); err != nil {
+		return err
+	}
+	if err := validateWeightEpochLength(p.WeightEpochLength); err != nil {
+		return err
+	}
+	if err := validateSunsetBatchSize(p.SunsetBatchSize); err != nil {
+		return err
+	}
+	return validateSDKBondTransferable(p.SdkBondTransferable)
+}
+
+func validateUnbondingRemainderRecipient(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(v); err != nil {
+		return fmt.Errorf("invalid unbonding remainder recipient: %w", err)
+	}
+
+	return nil
+}
+
+func validateReweightingBatchSize(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("reweighting batch size must be positive")
+	}
+
+	return nil
+}
+
+func validateWeightEpochLength(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("weight epoch length must be positive")
+	}
+
+	return nil
+}
+
+func validateSunsetBatchSize(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("sunset batch size must be positive")
+	}
+
+	return nil
+}
+
+func validateSDKBondTransferable(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
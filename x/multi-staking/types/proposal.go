@@ -0,0 +1,320 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
+const (
+	// ProposalTypeAddBondDenom defines the type for an AddBondDenomProposal
+	ProposalTypeAddBondDenom = "AddBondDenom"
+	// ProposalTypeChangeBondTokenWeight defines the type for a ChangeBondTokenWeightProposal
+	ProposalTypeChangeBondTokenWeight = "ChangeBondTokenWeight"
+	// ProposalTypeRemoveBondToken defines the type for a RemoveBondTokenProposal
+	ProposalTypeRemoveBondToken = "RemoveBondToken"
+	// ProposalTypeSetStakingCaps defines the type for a SetStakingCapsProposal
+	ProposalTypeSetStakingCaps = "SetStakingCaps"
+	// ProposalTypeSetWeightBounds defines the type for a SetWeightBoundsProposal
+	ProposalTypeSetWeightBounds = "SetWeightBounds"
+	// ProposalTypeConversionReserveSpend defines the type for a ConversionReserveSpendProposal
+	ProposalTypeConversionReserveSpend = "ConversionReserveSpend"
+)
+
+// Assert the proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = &AddBondDenomProposal{}
+	_ govtypes.Content = &ChangeBondTokenWeightProposal{}
+	_ govtypes.Content = &RemoveBondTokenProposal{}
+	_ govtypes.Content = &SetStakingCapsProposal{}
+	_ govtypes.Content = &SetWeightBoundsProposal{}
+	_ govtypes.Content = &ConversionReserveSpendProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeAddBondDenom)
+	govtypes.RegisterProposalType(ProposalTypeChangeBondTokenWeight)
+	govtypes.RegisterProposalType(ProposalTypeRemoveBondToken)
+	govtypes.RegisterProposalType(ProposalTypeSetStakingCaps)
+	govtypes.RegisterProposalType(ProposalTypeSetWeightBounds)
+	govtypes.RegisterProposalType(ProposalTypeConversionReserveSpend)
+}
+
+// NewAddBondDenomProposal creates a new add bond denom proposal. The source
+// channel is only set for IBC bond denoms, to pin the channel they must have
+// been received over.
+func NewAddBondDenomProposal(title, description, bondDenom string, bondTokenWeight sdk.Dec, sourceChannel string) *AddBondDenomProposal {
+	return &AddBondDenomProposal{title, description, bondDenom, bondTokenWeight, sourceChannel}
+}
+
+// GetTitle returns the title of an add bond denom proposal.
+func (p *AddBondDenomProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an add bond denom proposal.
+func (p *AddBondDenomProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an add bond denom proposal.
+func (p *AddBondDenomProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an add bond denom proposal.
+func (p *AddBondDenomProposal) ProposalType() string { return ProposalTypeAddBondDenom }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *AddBondDenomProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(p.BondDenom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidBondDenom, err.Error())
+	}
+	if err := ibctransfertypes.ValidateIBCDenom(p.BondDenom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidBondDenom, err.Error())
+	}
+	if p.SourceChannel != "" {
+		if !IsIBCDenom(p.BondDenom) {
+			return sdkerrors.Wrapf(ErrInvalidSourceChannel, "%s is not an IBC denom", p.BondDenom)
+		}
+		if err := host.ChannelIdentifierValidator(p.SourceChannel); err != nil {
+			return sdkerrors.Wrap(ErrInvalidSourceChannel, err.Error())
+		}
+	}
+	return validateBondTokenWeight(p.BondTokenWeight)
+}
+
+// String implements the Stringer interface.
+func (p AddBondDenomProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Add Bond Denom Proposal:
+  Title:             %s
+  Description:       %s
+  Bond Denom:        %s
+  Bond Token Weight: %s
+  Source Channel:    %s
+`, p.Title, p.Description, p.BondDenom, p.BondTokenWeight, p.SourceChannel))
+	return b.String()
+}
+
+// NewChangeBondTokenWeightProposal creates a new change bond token weight proposal.
+func NewChangeBondTokenWeightProposal(title, description, bondDenom string, bondTokenWeight sdk.Dec) *ChangeBondTokenWeightProposal {
+	return &ChangeBondTokenWeightProposal{title, description, bondDenom, bondTokenWeight}
+}
+
+// GetTitle returns the title of a change bond token weight proposal.
+func (p *ChangeBondTokenWeightProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a change bond token weight proposal.
+func (p *ChangeBondTokenWeightProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a change bond token weight proposal.
+func (p *ChangeBondTokenWeightProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a change bond token weight proposal.
+func (p *ChangeBondTokenWeightProposal) ProposalType() string {
+	return ProposalTypeChangeBondTokenWeight
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *ChangeBondTokenWeightProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(p.BondDenom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidBondDenom, err.Error())
+	}
+	return validateBondTokenWeight(p.BondTokenWeight)
+}
+
+// String implements the Stringer interface.
+func (p ChangeBondTokenWeightProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Change Bond Token Weight Proposal:
+  Title:             %s
+  Description:       %s
+  Bond Denom:        %s
+  Bond Token Weight: %s
+`, p.Title, p.Description, p.BondDenom, p.BondTokenWeight))
+	return b.String()
+}
+
+// NewRemoveBondTokenProposal creates a new remove bond token proposal.
+func NewRemoveBondTokenProposal(title, description, bondDenom string) *RemoveBondTokenProposal {
+	return &RemoveBondTokenProposal{title, description, bondDenom}
+}
+
+// GetTitle returns the title of a remove bond token proposal.
+func (p *RemoveBondTokenProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a remove bond token proposal.
+func (p *RemoveBondTokenProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a remove bond token proposal.
+func (p *RemoveBondTokenProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a remove bond token proposal.
+func (p *RemoveBondTokenProposal) ProposalType() string { return ProposalTypeRemoveBondToken }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *RemoveBondTokenProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(p.BondDenom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidBondDenom, err.Error())
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p RemoveBondTokenProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Remove Bond Token Proposal:
+  Title:       %s
+  Description: %s
+  Bond Denom:  %s
+`, p.Title, p.Description, p.BondDenom))
+	return b.String()
+}
+
+// NewSetStakingCapsProposal creates a new set staking caps proposal.
+func NewSetStakingCapsProposal(title, description, bondDenom string, caps StakingCaps) *SetStakingCapsProposal {
+	return &SetStakingCapsProposal{title, description, bondDenom, caps}
+}
+
+// GetTitle returns the title of a set staking caps proposal.
+func (p *SetStakingCapsProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a set staking caps proposal.
+func (p *SetStakingCapsProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a set staking caps proposal.
+func (p *SetStakingCapsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a set staking caps proposal.
+func (p *SetStakingCapsProposal) ProposalType() string { return ProposalTypeSetStakingCaps }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *SetStakingCapsProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(p.BondDenom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidBondDenom, err.Error())
+	}
+	return p.Caps.Validate()
+}
+
+// String implements the Stringer interface.
+func (p SetStakingCapsProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Staking Caps Proposal:
+  Title:             %s
+  Description:       %s
+  Bond Denom:        %s
+  Max Bonded Tokens: %s
+  Max Power Share:   %s
+`, p.Title, p.Description, p.BondDenom, p.Caps.MaxBondedTokens, p.Caps.MaxPowerShare))
+	return b.String()
+}
+
+// NewSetWeightBoundsProposal creates a new set weight bounds proposal. Zero
+// bounds make the weight of the bond denom static again.
+func NewSetWeightBoundsProposal(title, description, bondDenom string, bounds WeightBounds) *SetWeightBoundsProposal {
+	return &SetWeightBoundsProposal{title, description, bondDenom, bounds}
+}
+
+// GetTitle returns the title of a set weight bounds proposal.
+func (p *SetWeightBoundsProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a set weight bounds proposal.
+func (p *SetWeightBoundsProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a set weight bounds proposal.
+func (p *SetWeightBoundsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a set weight bounds proposal.
+func (p *SetWeightBoundsProposal) ProposalType() string { return ProposalTypeSetWeightBounds }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *SetWeightBoundsProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(p.BondDenom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidBondDenom, err.Error())
+	}
+	if p.Bounds.IsZero() {
+		return nil
+	}
+	return p.Bounds.Validate()
+}
+
+// String implements the Stringer interface.
+func (p SetWeightBoundsProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Weight Bounds Proposal:
+  Title:           %s
+  Description:     %s
+  Bond Denom:      %s
+  Min Weight:      %s
+  Max Weight:      %s
+  Max Change Rate: %s
+`, p.Title, p.Description, p.BondDenom, p.Bounds.MinWeight, p.Bounds.MaxWeight, p.Bounds.MaxChangeRate))
+	return b.String()
+}
+
+// NewConversionReserveSpendProposal creates a new conversion reserve spend proposal.
+func NewConversionReserveSpendProposal(title, description string, recipient sdk.AccAddress, amount sdk.Coins) *ConversionReserveSpendProposal {
+	return &ConversionReserveSpendProposal{title, description, recipient.String(), amount}
+}
+
+// GetTitle returns the title of a conversion reserve spend proposal.
+func (p *ConversionReserveSpendProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a conversion reserve spend proposal.
+func (p *ConversionReserveSpendProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a conversion reserve spend proposal.
+func (p *ConversionReserveSpendProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a conversion reserve spend proposal.
+func (p *ConversionReserveSpendProposal) ProposalType() string {
+	return ProposalTypeConversionReserveSpend
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *ConversionReserveSpendProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address: %s", err)
+	}
+	if !p.Amount.IsValid() || p.Amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, p.Amount.String())
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p ConversionReserveSpendProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Conversion Reserve Spend Proposal:
+  Title:       %s
+  Description: %s
+  Recipient:   %s
+  Amount:      %s
+`, p.Title, p.Description, p.Recipient, p.Amount))
+	return b.String()
+}
+
+func validateBondTokenWeight(weight sdk.Dec) error {
+	if weight.IsNil() || !weight.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidBondTokenWeight, "bond token weight must be positive: %s", weight)
+	}
+	return nil
+}
@@ -0,0 +1,6781 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: multistaking/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MultiStakingDelegation defines the bond tokens a delegator delegated to a
+// validator, along with the sdk delegation of its intermediary account backing
+// them.
+type MultiStakingDelegation struct {
+	DelegatorAddress    string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress    string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	IntermediaryAddress string `protobuf:"bytes,3,opt,name=intermediary_address,json=intermediaryAddress,proto3" json:"intermediary_address,omitempty"`
+	// bond_tokens is the bond tokens locked for the delegation.
+	BondTokens types.Coin `protobuf:"bytes,4,opt,name=bond_tokens,json=bondTokens,proto3" json:"bond_tokens"`
+	// sdk_bond_tokens is the sdkbond tokens minted for the bond tokens.
+	SDKBondTokens types.Coin `protobuf:"bytes,5,opt,name=sdk_bond_tokens,json=sdkBondTokens,proto3" json:"sdk_bond_tokens"`
+	// shares is the shares of the sdk delegation of the intermediary account.
+	Shares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=shares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares"`
+	// balance is the sdkbond tokens the shares are worth, which is lower than
+	// sdk_bond_tokens once the validator is slashed.
+	Balance types.Coin `protobuf:"bytes,7,opt,name=balance,proto3" json:"balance"`
+}
+
+func (m *MultiStakingDelegation) Reset()         { *m = MultiStakingDelegation{} }
+func (m *MultiStakingDelegation) String() string { return proto.CompactTextString(m) }
+func (*MultiStakingDelegation) ProtoMessage()    {}
+func (*MultiStakingDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82d174b604da394d, []int{0}
+}
+func (m *MultiStakingDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiStakingDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiStakingDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiStakingDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiStakingDelegation.Merge(m, src)
+}
+func (m *MultiStakingDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiStakingDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiStakingDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiStakingDelegation proto.InternalMessageInfo
+
+// MultiStakingUnbonding defines the bond tokens of a delegator unbonding from
+// a validator until a completion time, along with the sdk unbonding delegation
+// entries of its intermediary account backing them.
+type MultiStakingUnbonding struct {
+	DelegatorAddress string    `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string    `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	CreationHeight   int64     `protobuf:"varint,3,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
+	CompletionTime   time.Time `protobuf:"bytes,4,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+	// bond_tokens is the bond tokens locked for the unbonding.
+	BondTokens types.Coin `protobuf:"bytes,5,opt,name=bond_tokens,json=bondTokens,proto3" json:"bond_tokens"`
+	// sdk_bond_tokens is the sdkbond tokens minted for the bond tokens.
+	SDKBondTokens types.Coin `protobuf:"bytes,6,opt,name=sdk_bond_tokens,json=sdkBondTokens,proto3" json:"sdk_bond_tokens"`
+	// balance is the sdkbond tokens the sdk unbonding delegation entries will
+	// return, which is lower than sdk_bond_tokens once they are slashed.
+	Balance types.Coin `protobuf:"bytes,7,opt,name=balance,proto3" json:"balance"`
+}
+
+func (m *MultiStakingUnbonding) Reset()         { *m = MultiStakingUnbonding{} }
+func (m *MultiStakingUnbonding) String() string { return proto.CompactTextString(m) }
+func (*MultiStakingUnbonding) ProtoMessage()    {}
+func (*MultiStakingUnbonding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82d174b604da394d, []int{1}
+}
+func (m *MultiStakingUnbonding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiStakingUnbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiStakingUnbonding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiStakingUnbonding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiStakingUnbonding.Merge(m, src)
+}
+func (m *MultiStakingUnbonding) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiStakingUnbonding) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiStakingUnbonding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiStakingUnbonding proto.InternalMessageInfo
+
+// QueryIntermediaryAccountRequest is request type for the
+// Query/IntermediaryAccount RPC method.
+type QueryIntermediaryAccountRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address defines the validator address to query for.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QueryIntermediaryAccountRequest) Reset()         { *m = QueryIntermediaryAccountRequest{} }
+func (m *QueryIntermediaryAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIntermediaryAccountRequest) ProtoMessage()    {}
+func (*QueryIntermediaryAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82d174b604da394d, []int{2}
+}
+func (m *QueryIntermediaryAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIntermediaryAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIntermediaryAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIntermediaryAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntermediaryAccountRequest.Merge(m, src)
+}
+func (m *QueryIntermediaryAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIntermediaryAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntermediaryAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIntermediaryAccountRequest proto.InternalMessageInfo
+
+func (m *QueryIntermediaryAccountRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *QueryIntermediaryAccountRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// QueryIntermediaryAccountResponse is response type for the
+// Query/IntermediaryAccount RPC method.
+type QueryIntermediaryAccountResponse struct {
+	// intermediary_address is the address of the intermediary account.
+	IntermediaryAddress string `protobuf:"bytes,1,opt,name=intermediary_address,json=intermediaryAddress,proto3" json:"intermediary_address,omitempty"`
+}
+
+func (m *QueryIntermediaryAccountResponse) Reset()         { *m = QueryIntermediaryAccountResponse{} }
+func (m *QueryIntermediaryAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIntermediaryAccountResponse) ProtoMessage()    {}
+func (*QueryIntermediaryAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82d174b604da394d, []int{3}
+}
+func (m *QueryIntermediaryAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIntermediaryAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIntermediaryAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIntermediaryAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntermediaryAccountResponse.Merge(m, src)
+}
+func (m *QueryIntermediaryAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIntermediaryAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntermediaryAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIntermediaryAccountResponse proto.InternalMessageInfo
+
+func (m *QueryIntermediaryAccountResponse) GetIntermediaryAddress() string {
+	if m != nil {
+		return m.IntermediaryAddress
+	}
+	return ""
+}
+
+// QueryIntermediaryAccountDelegatorRequest is request type for the
+// Query/IntermediaryAccountDelegator RPC method.
+type QueryIntermediaryAccountDelegatorRequest struct {
+	// intermediary_address defines the intermediary account address to query for.
+	IntermediaryAddress string `protobuf:"bytes,1,opt,name=intermediary_address,json=intermediaryAddress,proto3" json:"intermediary_address,omitempty"`
+}
+
+func (m *QueryIntermediaryAccountDelegatorRequest) Reset() {
+	*m = QueryIntermediaryAccountDelegatorRequest{}
+}
+func (m *QueryIntermediaryAccountDelegatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIntermediaryAccountDelegatorRequest) ProtoMessage()    {}
+func (*QueryIntermediaryAccountDelegatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82d174b604da394d, []int{4}
+}
+func (m *QueryIntermediaryAccountDelegatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIntermediaryAccountDelegatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIntermediaryAccountDelegatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIntermediaryAccountDelegatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntermediaryAccountDelegatorRequest.Merge(m, src)
+}
+func (m *QueryIntermediaryAccountDelegatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIntermediaryAccountDelegatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntermediaryAccountDelegatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIntermediaryAccountDelegatorRequest proto.InternalMessageInfo
+
+func (m *QueryIntermediaryAccountDelegatorRequest) GetIntermediaryAddress() string {
+	if m != nil {
+		return m.IntermediaryAddress
+	}
+	return ""
+}
+
+// QueryIntermediaryAccountDelegatorResponse is response type for the
+// Query/IntermediaryAccountDelegator RPC method.
+type QueryIntermediaryAccountDelegatorResponse struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *QueryIntermediaryAccountDelegatorResponse) Reset() {
+	*m = QueryIntermediaryAccountDelegatorResponse{}
+}
+func (m *QueryIntermediaryAccountDelegatorResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryIntermediaryAccountDelegatorResponse) ProtoMessage() {}
+func (*QueryIntermediaryAccountDelegatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82d174b604da394d, []int{5}
+}
+func (m *QueryIntermediaryAccountDelegatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIntermediaryAccountDelegatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIntermediaryAccountDelegatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIntermediaryAccountDelegatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntermediaryAccountDelegatorResponse.Merge(m, src)
+}
+func (m *QueryIntermediaryAccountDelegatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIntermediaryAccountDelegatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntermediaryAccountDelegatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIntermediaryAccountDelegatorResponse proto.InternalMessageInfo
+
+func (m *QueryIntermediaryAccountDelegatorResponse) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+// QueryBondTokenWeightsRequest is request type for the
+// Query/BondTokenWeights RPC method.
+type QueryBondTokenWeightsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBondTokenWeightsRequest) Reset()         { *m = QueryBondTokenWeightsRequest{} }
+func (m *QueryBondTokenWeightsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBondTokenWeightsRequest) ProtoMessage()    {}
+func (*QueryBondTokenWeightsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82d174b604da394d, []int{6}
+}
+func (m *QueryBondTokenWeightsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBondTokenWeightsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBondTokenWeightsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBondTokenWeightsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBondTokenWeightsRequest.Merge(m, src)
+}
+func (m *QueryBondTokenWeightsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBondTokenWeightsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBondTokenWeightsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBondTokenWeightsRequest proto.InternalMessageInfo
+
+func (m *QueryBondTokenWeightsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBondTokenWeightsResponse is response type for the
+// Query/BondTokenWeights RPC method.
+type QueryBondTokenWeightsResponse struct {
+	BondTokenWeights []BondTokenWeightInfo `protobuf:"bytes,1,rep,name=bond_token_weights,json=bondTokenWeights,proto3" json:"bond_token_weights"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBondTokenWeightsResponse) Reset()         { *m = QueryBondTokenWeightsResponse{} }
+func (m *QueryBondTokenWeightsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBondTokenWeightsResponse) ProtoMessage()    {}
+func (*QueryBondTokenWeightsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82d174b604da394d, []int{7}
+}
+func (m *QueryBondTokenWeightsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBondTokenWeightsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBondTokenWeightsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBondTokenWeightsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBondTokenWeightsResponse.Merge(m, src)
+}
+func (m *QueryBondTokenWeightsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBondTokenWeightsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBondTokenWeightsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBondTokenWeightsResponse proto.InternalMessageInfo
+
+func (m *QueryBondTokenWeightsResponse) GetBondTokenWeights() []BondTokenWeightInfo {
+	if m != nil {
+		return m.BondTokenWeights
+	}
+	return nil
+}
+
+func (m *QueryBondTokenWeightsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// BondTokenWeightInfo is the weight of a bond denom with the base denom of the
+// bond denom.
+type BondTokenWeightInfo struct {
+	BondDenom       string                                 `protobuf:"bytes,1,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
+	BondTokenWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=bond_token_weight,json=bondTokenWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bond_token_weight"`
+	// base_denom is the base denom of an IBC bond denom resolved through its
+	// denom trace, or the bond denom itself.
+	BaseDenom string `protobuf:"bytes,3,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+}
+
+func (m *BondTokenWeightInfo) Reset()         { *m = BondTokenWeightInfo{} }
+func (m *BondTokenWeightInfo) String() string { return proto.CompactTextString(m) }
+func (*BondTokenWeightInfo) ProtoMessage()    {}
+func (*BondTokenWeightInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82d174b604da394d, []int{8}
+}
+func (m *BondTokenWeightInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BondTokenWeightInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BondTokenWeightInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BondTokenWeightInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BondTokenWeightInfo.Merge(m, src)
+}
+func (m *BondTokenWeightInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *BondTokenWeightInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BondTokenWeightInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BondTokenWeightInfo proto.InternalMessageInfo
+
+func (m *BondTokenWeightInfo) GetBondDenom() string {
+	if m != nil {
+		return m.BondDenom
+	}
+	return ""
+}
+
+func (m *BondTokenWeightInfo) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+// QueryBondTokenWeightRequest is request type for the
+// Query/BondTokenWeight RPC method.
+type QueryBondTokenWeightRequest struct {
+	// bond_denom defines the bond denom to query for.
+	BondDenom string `protobuf:"bytes,1,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
+}
+
+func (m *QueryBondTokenWeightRequest) Reset()         { *m = QueryBondTokenWeightRequest{} }
+func (m *QueryBondTokenWeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBondTokenWeightRequest) ProtoMessage()    {}
+func (*QueryBondTokenWeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82d174b604da394d, []int{9}
+}
+func (m *QueryBondTokenWeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBondTokenWeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBondTokenWeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBondTokenWeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBondTokenWeightRequest.Merge(m, src)
+}
+func (m *QueryBondTokenWeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBondTokenWeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBondTokenWeightRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBondTokenWeightRequest proto.InternalMessageInfo
+
+func (m *QueryBondTokenWeightRequest) GetBondDenom() string {
+	if m != nil {
+		return m.BondDenom
+	}
+	return ""
+}
+
+// QueryBondTokenWeightResponse is response type for the
+// Query/BondTokenWeight RPC method.
+type QueryBondTokenWeightResponse struct {
+	BondTokenWeight BondTokenWeightInfo `protobuf:"bytes,1,opt,name=bond_token_weight,json=bondTokenWeight,proto3" json:"bond_token_weight"`
+	// sunsetting is whether the bond denom is being removed.
+	Sunsetting bool `protobuf:"varint,2,opt,name=sunsetting,proto3" json:"sunsetting,omitempty"`
+	// weight_bounds are the bounds of the weight of a bond denom whose weight is
+	// recomputed every epoch from the weight provider, or nil.
+	WeightBounds *WeightBounds `protobuf:"bytes,3,opt,name=weight_bounds,json=weightBounds,proto3" json:"weight_bounds,omitempty"`
+}
+
+func (m *QueryBondTokenWeightResponse) Reset()         { *m = QueryBondTokenWeightResponse{} }
+func (m *QueryBondTokenWeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBondTokenWeightResponse) ProtoMessage()    {}
+func (*QueryBondTokenWeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82d174b604da394d, []int{10}
+}
+func (m *QueryBondTokenWeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBondTokenWeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBondTokenWeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBondTokenWeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBondTokenWeightResponse.Merge(m, src)
+}
+func (m *QueryBondTokenWeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBondTokenWeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBondTokenWeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBondTokenWeightResponse proto.InternalMessageInfo
+
+func (m *QueryBondTokenWeightResponse) GetBondTokenWeight() BondTokenWeightInfo {
+	if m != nil {
+		return m.BondTokenWeight
+	}
+	return BondTokenWeightInfo{}
+}
+
+func (m *QueryBondTokenWeightResponse) GetSunsetting() bool {
+	if m != nil {
+		return m.Sunsetting
+	}
+	return false
+}
+
+func (m *QueryBondTokenWeightResponse) GetWeightBounds() *WeightBounds {
+	if m != nil {
+		return m.WeightBounds
+	}
+	return nil
+}
+
+// QueryValidatorBondDenomRequest is request type for the
+// Query/ValidatorBondDenom RPC method.
+type QueryValidatorBondDenomRequest struct {
+	// validator_address defines the validator address to query for.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QueryValidatorBondDenomRequest) Reset()         { *m = QueryValidatorBondDenomRequest{} }
+func (m *QueryValidatorBondDenomRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorBondDenomRequest) ProtoMessage()    {}
+func (*QueryValidatorBondDenomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82d174b604da394d, []int{11}
+}
+func (m *QueryValidatorBondDenomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorBondDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorBondDenomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorBondDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorBondDenomRequest.Merge(m, src)
+}
+func (m *QueryValidatorBondDenomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorBondDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorBondDenomRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorBondDenomRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorBondDenomRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// QueryValidatorBondDenomResponse is response type for the
+// Query/ValidatorBondDenom RPC method.
+type QueryValidatorBondDenomResponse struct {
+	// bond_denom is the bond denom the validator is pinned to.
+	BondDenom string `protobuf:"bytes,1,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
+	// base_denom is the base denom of an IBC bond denom resolved through its
+	// denom trace, or the bond denom itself.
+	BaseDenom string `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+}
+
+func (m *QueryValidatorBondDenomResponse) Reset()         { *m = QueryValidatorBondDenomResponse{} }
+func (m *QueryValidatorBondDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorBondDenomResponse) ProtoMessage()    {}
+func (*QueryValidatorBondDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82d174b604da394d, []int{12}
+}
+func (m *QueryValidatorBondDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorBondDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorBondDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorBondDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorBondDenomResponse.Merge(m, src)
+}
+func (m *QueryValidatorBondDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorBondDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorBondDenomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorBondDenomResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorBondDenomResponse) GetBondDenom() string {
+	if m != nil {
+		return m.BondDenom
+	}
+	return ""
+}
+
+func (m *QueryValidatorBondDenomResponse) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+// StakingHeadroom defines the staking caps of a bond denom, its current
+// staking and the bond tokens that can still be delegated within the caps.
+type StakingHeadroom struct {
+	BondDenom string      `protobuf:"bytes,1,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
+	Caps      StakingCaps `protobuf:"bytes,2,opt,name=caps,proto3" json:"caps"`
+	// bonded_tokens is the bond tokens of the bond denom delegated.
+	BondedTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=bonded_tokens,json=bondedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"bonded_tokens"`
+	// power_share is the share of the tokens of all bonded validators held by
+	// the bonded validators pinned to the bond denom.
+	PowerShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=power_share,json=powerShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"power_share"`
+	// capped is whether any staking cap is set for the bond denom.
+	Capped bool `protobuf:"varint,5,opt,name=capped,proto3" json:"capped,omitempty"`
+	// headroom is the bond tokens that can still be delegated within the caps.
+	// It is only set if the bond denom is capped.
+	Headroom github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=headroom,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"headroom"`
+}
+
+func (m *StakingHeadroom) Reset()         { *m = StakingHeadroom{} }
+func (m *StakingHeadroom) String() string { return proto.CompactTextString(m) }
+func (*StakingHeadroom) ProtoMessage()    {}
+func (*StakingHeadroom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82d174b604da394d, []int{13}
+}
+func (m *StakingHeadroom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakingHeadroom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakingHeadroom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakingHeadroom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakingHeadroom.Merge(m, src)
+}
+func (m *StakingHeadroom) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakingHeadroom) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakingHeadroom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakingHeadroom proto.InternalMessageInfo
+
+// QueryStakingHeadroomsRequest is request type for the
+// Query/StakingHeadrooms RPC method.
+type QueryStakingHeadroomsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStakingHeadroomsRequest) Reset()         { *m = QueryStakingHeadroomsRequest{} }
+func (m *QueryStakingHeadroomsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStakingHeadroomsRequest) ProtoMessage()    {}
+func (*QueryStakingHeadroomsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82d174b604da394d, []int{14}
+}
+func (m *QueryStakingHeadroomsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakingHeadroomsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakingHeadroomsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStakingHeadroomsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakingHeadroomsRequest.Merge(m, src)
+}
+func (m *QueryStakingHeadroomsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakingHeadroomsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakingHeadroomsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStakingHeadroomsRequest proto.InternalMessageInfo
+
+func (m *QueryStakingHeadroomsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryStakingHeadroomsResponse is response type for the
+// Query/StakingHeadrooms RPC method.
+type QueryStakingHeadroomsResponse struct {
+	Headrooms []StakingHeadroom `protobuf:"bytes,1,rep,name=headrooms,proto3" json:"headrooms"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStakingHeadroomsResponse) Reset()         { *m = QueryStakingHeadroomsResponse{} }
+func (m *QueryStakingHeadroomsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStakingHeadroomsResponse) ProtoMessage()    {}
+func (*QueryStakingHeadroomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82d174b604da394d, []int{15}
+}
+func (m *QueryStakingHeadroomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakingHeadroomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakingHeadroomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStakingHeadroomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakingHeadroomsResponse.Merge(m, src)
+}
+func (m *QueryStakingHeadroomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakingHeadroomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakingHeadroomsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStakingHeadroomsResponse proto.InternalMessageInfo
+
+func (m *QueryStakingHeadroomsResponse) GetHeadrooms() []StakingHeadroom {
+	if m != nil {
+		return m.Headrooms
+	}
+	return nil
+}
+
+func (m *QueryStakingHeadroomsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryStakingHeadroomRequest is request type for the
+// Query/StakingHeadroom RPC method.
+type QueryStakingHeadroomRequest struct {
+	// bond_denom defines the bond denom to query for.
+	BondDenom string `protobuf:"bytes,1,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
+}
+
+func (m *QueryStakingHeadroomRequest) Reset()         { *m = QueryStakingHeadroomRequest{} }
+func (m *QueryStakingHeadroomRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStakingHeadroomRequest) ProtoMessage()    {}
+func (*QueryStakingHeadroomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82d174b604da394d, []int{16}
+}
+func (m *QueryStakingHeadroomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakingHeadroomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakingHeadroomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStakingHeadroomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakingHeadroomRequest.Merge(m, src)
+}
+func (m *QueryStakingHeadroomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakingHeadroomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakingHeadroomRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStakingHeadroomRequest proto.InternalMessageInfo
+
+func (m *QueryStakingHeadroomRequest) GetBondDenom() string {
+	if m != nil {
+		return m.BondDenom
+	}
+	return ""
+}
+
+// QueryStakingHeadroomResponse is response type for the
+// Query/StakingHeadroom RPC method.
+type QueryStakingHeadroomResponse struct {
+	Headroom StakingHeadroom `protobuf:"bytes,1,opt,name=headroom,proto3" json:"headroom"`
+}
+
+func (m *QueryStakingHeadroomResponse) Reset()         { *m = QueryStakingHeadroomResponse{} }
+func (m *QueryStakingHeadroomResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStakingHeadroomResponse) ProtoMessage()    {}
+func (*QueryStakingHeadroomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82d174b604da394d, []int{17}
+}
+func (m *QueryStakingHeadroomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakingHeadroomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakingHeadroomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStakingHeadroomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakingHeadroomResponse.Merge(m, src)
+}
+func (m *QueryStakingHeadroomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakingHeadroomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakingHeadroomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStakingHeadroomResponse proto.InternalMessageInfo
+
+func (m *QueryStakingHeadroomResponse) GetHeadroom() StakingHeadroom {
+	if m != nil {
+		return m.Headroom
+	}
+	return StakingHeadroom{}
+}
+
+// QueryMultiStakingDelegationRequest is request type for the
+// Query/MultiStakingDelegation RPC method.
+type QueryMultiStakingDelegationRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address defines the validator address to query for.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QueryMultiStakingDelegationRequest) Reset()         { *m = QueryMultiStakingDelegationRequest{} }
+func (m *QueryMultiStakingDelegationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMultiStakingDelegationRequest) ProtoMessage()    {}
+func (*QueryMultiStakingDelegationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82d174b604da394d, []int{18}
+}
+func (m *QueryMultiStakingDelegationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMultiStakingDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMultiStakingDelegationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMultiStakingDelegationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMultiStakingDelegationRequest.Merge(m, src)
+}
+func (m *QueryMultiStakingDelegationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMultiStakingDelegationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMultiStakingDelegationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMultiStakingDelegationRequest proto.InternalMessageInfo
+
+func (m *QueryMultiStakingDelegationRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *QueryMultiStakingDelegationRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// QueryMultiStakingDelegationResponse is response type for the
+// Query/MultiStakingDelegation RPC method.
+type QueryMultiStakingDelegationResponse struct {
+	Delegation MultiStakingDelegation `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation"`
+}
+
+func (m *QueryMultiStakingDelegationResponse) Reset()         { *m = QueryMultiStakingDelegationResponse{} }
+func (m *QueryMultiStakingDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMultiStakingDelegationResponse) ProtoMessage()    {}
+func (*QueryMultiStakingDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82d174b604da394d, []int{19}
+}
+func (m *QueryMultiStakingDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMultiStakingDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMultiStakingDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMultiStakingDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMultiStakingDelegationResponse.Merge(m, src)
+}
+func (m *QueryMultiStakingDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMultiStakingDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMultiStakingDelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMultiStakingDelegationResponse proto.InternalMessageInfo
+
+func (m *QueryMultiStakingDelegationResponse) GetDelegation() MultiStakingDelegation {
+	if m != nil {
+		return m.Delegation
+	}
+	return MultiStakingDelegation{}
+}
+
+// QueryDelegatorMultiStakingDelegationsRequest is request type for the
+// Query/DelegatorMultiStakingDelegations RPC method.
+type QueryDelegatorMultiStakingDelegationsRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegatorMultiStakingDelegationsRequest) Reset() {
+	*m = QueryDelegatorMultiStakingDelegationsRequest{}
+}
+func (m *QueryDelegatorMultiStakingDelegationsRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDelegatorMultiStakingDelegationsRequest) ProtoMessage() {}
+func (*QueryDelegatorMultiStakingDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82d174b604da394d, []int{20}
+}
+func (m *QueryDelegatorMultiStakingDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorMultiStakingDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorMultiStakingDelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorMultiStakingDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorMultiStakingDelegationsRequest.Merge(m, src)
+}
+func (m *QueryDelegatorMultiStakingDelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorMultiStakingDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorMultiStakingDelegationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorMultiStakingDelegationsRequest proto.InternalMessageInfo
+
+func (m *QueryDelegatorMultiStakingDelegationsRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *QueryDelegatorMultiStakingDelegationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDelegatorMultiStakingDelegationsResponse is response type for the
+// Query/DelegatorMultiStakingDelegations RPC method.
+type QueryDelegatorMultiStakingDelegationsResponse struct {
+	Delegations []MultiStakingDelegation `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegatorMultiStakingDelegationsResponse) Reset() {
+	*m = QueryDelegatorMultiStakingDelegationsResponse{}
+}
+func (m *QueryDelegatorMultiStakingDelegationsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDelegatorMultiStakingDelegationsResponse) ProtoMessage() {}
+func (*QueryDelegatorMultiStakingDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82d174b604da394d, []int{21}
+}
+func (m *QueryDelegatorMultiStakingDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorMultiStakingDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorMultiStakingDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorMultiStakingDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorMultiStakingDelegationsResponse.Merge(m, src)
+}
+func (m *QueryDelegatorMultiStakingDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorMultiStakingDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorMultiStakingDelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorMultiStakingDelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorMultiStakingDelegationsResponse) GetDelegations() []MultiStakingDelegation {
+	if m != nil {
+		return m.Delegations
+	}
+	return nil
+}
+
+func (m *QueryDelegatorMultiStakingDelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMultiStakingUnbondingsRequest is request type for the
+// Query/MultiStakingUnbondings RPC method.
+type QueryMultiStakingUnbondingsRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMultiStakingUnbondingsRequest) Reset()         { *m = QueryMultiStakingUnbondingsRequest{} }
+func (m *QueryMultiStakingUnbondingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMultiStakingUnbondingsRequest) ProtoMessage()    {}
+func (*QueryMultiStakingUnbondingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82d174b604da394d, []int{22}
+}
+func (m *QueryMultiStakingUnbondingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMultiStakingUnbondingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMultiStakingUnbondingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMultiStakingUnbondingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMultiStakingUnbondingsRequest.Merge(m, src)
+}
+func (m *QueryMultiStakingUnbondingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMultiStakingUnbondingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMultiStakingUnbondingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMultiStakingUnbondingsRequest proto.InternalMessageInfo
+
+func (m *QueryMultiStakingUnbondingsRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *QueryMultiStakingUnbondingsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMultiStakingUnbondingsResponse is response type for the
+// Query/MultiStakingUnbondings RPC method.
+type QueryMultiStakingUnbondingsResponse struct {
+	Unbondings []MultiStakingUnbonding `protobuf:"bytes,1,rep,name=unbondings,proto3" json:"unbondings"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMultiStakingUnbondingsResponse) Reset()         { *m = QueryMultiStakingUnbondingsResponse{} }
+func (m *QueryMultiStakingUnbondingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMultiStakingUnbondingsResponse) ProtoMessage()    {}
+func (*QueryMultiStakingUnbondingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82d174b604da394d, []int{23}
+}
+func (m *QueryMultiStakingUnbondingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMultiStakingUnbondingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMultiStakingUnbondingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMultiStakingUnbondingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMultiStakingUnbondingsResponse.Merge(m, src)
+}
+func (m *QueryMultiStakingUnbondingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMultiStakingUnbondingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMultiStakingUnbondingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMultiStakingUnbondingsResponse proto.InternalMessageInfo
+
+func (m *QueryMultiStakingUnbondingsResponse) GetUnbondings() []MultiStakingUnbonding {
+	if m != nil {
+		return m.Unbondings
+	}
+	return nil
+}
+
+func (m *QueryMultiStakingUnbondingsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryConversionReserveRequest is request type for the
+// Query/ConversionReserve RPC method.
+type QueryConversionReserveRequest struct {
+}
+
+func (m *QueryConversionReserveRequest) Reset()         { *m = QueryConversionReserveRequest{} }
+func (m *QueryConversionReserveRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConversionReserveRequest) ProtoMessage()    {}
+func (*QueryConversionReserveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82d174b604da394d, []int{24}
+}
+func (m *QueryConversionReserveRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConversionReserveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConversionReserveRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConversionReserveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConversionReserveRequest.Merge(m, src)
+}
+func (m *QueryConversionReserveRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConversionReserveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConversionReserveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConversionReserveRequest proto.InternalMessageInfo
+
+// QueryConversionReserveResponse is response type for the
+// Query/ConversionReserve RPC method.
+type QueryConversionReserveResponse struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// balance is the bond tokens held by the conversion reserve.
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+}
+
+func (m *QueryConversionReserveResponse) Reset()         { *m = QueryConversionReserveResponse{} }
+func (m *QueryConversionReserveResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConversionReserveResponse) ProtoMessage()    {}
+func (*QueryConversionReserveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82d174b604da394d, []int{25}
+}
+func (m *QueryConversionReserveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConversionReserveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConversionReserveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConversionReserveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConversionReserveResponse.Merge(m, src)
+}
+func (m *QueryConversionReserveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConversionReserveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConversionReserveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConversionReserveResponse proto.InternalMessageInfo
+
+func (m *QueryConversionReserveResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryConversionReserveResponse) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MultiStakingDelegation)(nil), "multistaking.v1.MultiStakingDelegation")
+	proto.RegisterType((*MultiStakingUnbonding)(nil), "multistaking.v1.MultiStakingUnbonding")
+	proto.RegisterType((*QueryIntermediaryAccountRequest)(nil), "multistaking.v1.QueryIntermediaryAccountRequest")
+	proto.RegisterType((*QueryIntermediaryAccountResponse)(nil), "multistaking.v1.QueryIntermediaryAccountResponse")
+	proto.RegisterType((*QueryIntermediaryAccountDelegatorRequest)(nil), "multistaking.v1.QueryIntermediaryAccountDelegatorRequest")
+	proto.RegisterType((*QueryIntermediaryAccountDelegatorResponse)(nil), "multistaking.v1.QueryIntermediaryAccountDelegatorResponse")
+	proto.RegisterType((*QueryBondTokenWeightsRequest)(nil), "multistaking.v1.QueryBondTokenWeightsRequest")
+	proto.RegisterType((*QueryBondTokenWeightsResponse)(nil), "multistaking.v1.QueryBondTokenWeightsResponse")
+	proto.RegisterType((*BondTokenWeightInfo)(nil), "multistaking.v1.BondTokenWeightInfo")
+	proto.RegisterType((*QueryBondTokenWeightRequest)(nil), "multistaking.v1.QueryBondTokenWeightRequest")
+	proto.RegisterType((*QueryBondTokenWeightResponse)(nil), "multistaking.v1.QueryBondTokenWeightResponse")
+	proto.RegisterType((*QueryValidatorBondDenomRequest)(nil), "multistaking.v1.QueryValidatorBondDenomRequest")
+	proto.RegisterType((*QueryValidatorBondDenomResponse)(nil), "multistaking.v1.QueryValidatorBondDenomResponse")
+	proto.RegisterType((*StakingHeadroom)(nil), "multistaking.v1.StakingHeadroom")
+	proto.RegisterType((*QueryStakingHeadroomsRequest)(nil), "multistaking.v1.QueryStakingHeadroomsRequest")
+	proto.RegisterType((*QueryStakingHeadroomsResponse)(nil), "multistaking.v1.QueryStakingHeadroomsResponse")
+	proto.RegisterType((*QueryStakingHeadroomRequest)(nil), "multistaking.v1.QueryStakingHeadroomRequest")
+	proto.RegisterType((*QueryStakingHeadroomResponse)(nil), "multistaking.v1.QueryStakingHeadroomResponse")
+	proto.RegisterType((*QueryMultiStakingDelegationRequest)(nil), "multistaking.v1.QueryMultiStakingDelegationRequest")
+	proto.RegisterType((*QueryMultiStakingDelegationResponse)(nil), "multistaking.v1.QueryMultiStakingDelegationResponse")
+	proto.RegisterType((*QueryDelegatorMultiStakingDelegationsRequest)(nil), "multistaking.v1.QueryDelegatorMultiStakingDelegationsRequest")
+	proto.RegisterType((*QueryDelegatorMultiStakingDelegationsResponse)(nil), "multistaking.v1.QueryDelegatorMultiStakingDelegationsResponse")
+	proto.RegisterType((*QueryMultiStakingUnbondingsRequest)(nil), "multistaking.v1.QueryMultiStakingUnbondingsRequest")
+	proto.RegisterType((*QueryMultiStakingUnbondingsResponse)(nil), "multistaking.v1.QueryMultiStakingUnbondingsResponse")
+	proto.RegisterType((*QueryConversionReserveRequest)(nil), "multistaking.v1.QueryConversionReserveRequest")
+	proto.RegisterType((*QueryConversionReserveResponse)(nil), "multistaking.v1.QueryConversionReserveResponse")
+}
+
+func init() { proto.RegisterFile("multistaking/v1/query.proto", fileDescriptor_82d174b604da394d) }
+
+var fileDescriptor_82d174b604da394d = []byte{
+	// 1652 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcf, 0x6f, 0x13, 0xc7,
+	0x17, 0xcf, 0xc6, 0x21, 0x84, 0x17, 0x42, 0xc2, 0xf0, 0x43, 0xc6, 0x24, 0x76, 0xb4, 0xe1, 0x0b,
+	0xf9, 0x52, 0xec, 0x4d, 0x02, 0x6a, 0x0b, 0x85, 0xaa, 0x75, 0x52, 0x20, 0xa2, 0x88, 0xd6, 0xa1,
+	0x94, 0xb6, 0x42, 0xd6, 0xda, 0x3b, 0x6c, 0x56, 0xb1, 0x77, 0xcd, 0xce, 0x3a, 0x29, 0x8a, 0x72,
+	0xe9, 0xa9, 0x47, 0xa4, 0x9e, 0x5b, 0xd1, 0x4b, 0x5b, 0x71, 0x68, 0xa5, 0x96, 0x1b, 0xed, 0xa5,
+	0x97, 0xd2, 0x1b, 0xa2, 0x97, 0x8a, 0x03, 0x54, 0xa1, 0xa2, 0x3d, 0xf5, 0x4f, 0xa8, 0xaa, 0x9d,
+	0x9d, 0xd9, 0x5d, 0xef, 0x0f, 0x7b, 0x1d, 0xf9, 0x90, 0x13, 0xde, 0x99, 0x79, 0x3f, 0x3e, 0xef,
+	0x7d, 0xe6, 0xcd, 0x7b, 0x01, 0x0e, 0xd7, 0x9b, 0x35, 0x4b, 0x23, 0x96, 0xbc, 0xa2, 0xe9, 0xaa,
+	0xb4, 0x3a, 0x2b, 0xdd, 0x6a, 0x62, 0xf3, 0x76, 0xa1, 0x61, 0x1a, 0x96, 0x81, 0x46, 0xfd, 0x9b,
+	0x85, 0xd5, 0xd9, 0xcc, 0xb8, 0x6a, 0x18, 0x6a, 0x0d, 0x4b, 0x72, 0x43, 0x93, 0x64, 0x5d, 0x37,
+	0x2c, 0xd9, 0xd2, 0x0c, 0x9d, 0x38, 0xc7, 0x33, 0x39, 0xb6, 0x4b, 0xbf, 0x2a, 0xcd, 0x9b, 0x92,
+	0xa5, 0xd5, 0x31, 0xb1, 0xe4, 0x7a, 0x83, 0x1d, 0xd8, 0xaf, 0x1a, 0xaa, 0x41, 0x7f, 0x4a, 0xf6,
+	0x2f, 0xb6, 0x7a, 0xa8, 0x6a, 0x90, 0xba, 0x41, 0xca, 0xce, 0x86, 0xf3, 0xc1, 0xb6, 0x8e, 0x3b,
+	0x5f, 0x52, 0x45, 0x26, 0xd8, 0xf1, 0x4c, 0x5a, 0x9d, 0xad, 0x60, 0x4b, 0x9e, 0x95, 0x1a, 0xb2,
+	0xaa, 0xe9, 0xd4, 0x3c, 0x3b, 0x9b, 0xf5, 0x9f, 0xe5, 0xa7, 0xaa, 0x86, 0xc6, 0xf7, 0x27, 0x82,
+	0x48, 0x55, 0xac, 0x63, 0xa2, 0x71, 0x53, 0x53, 0xc1, 0x6d, 0xfa, 0x5d, 0xe6, 0xe0, 0xe9, 0x21,
+	0xf1, 0xde, 0x00, 0x1c, 0xbc, 0x6c, 0xaf, 0x2f, 0x39, 0xcb, 0x0b, 0xb8, 0x86, 0x55, 0xea, 0x04,
+	0x7a, 0x0b, 0xf6, 0x2a, 0xce, 0x97, 0x61, 0x96, 0x65, 0x45, 0x31, 0x31, 0x21, 0x69, 0x61, 0x52,
+	0x98, 0xde, 0x55, 0x4c, 0x3f, 0xbe, 0x9f, 0xdf, 0xcf, 0x70, 0xbd, 0xe9, 0xec, 0x2c, 0x59, 0xa6,
+	0xa6, 0xab, 0xa5, 0x31, 0x57, 0x84, 0xad, 0xdb, 0x6a, 0x56, 0xe5, 0x9a, 0xa6, 0xb4, 0xa8, 0xe9,
+	0xef, 0xa4, 0xc6, 0x15, 0xe1, 0x6a, 0x2e, 0xc1, 0x7e, 0x4d, 0xb7, 0xb0, 0x59, 0xc7, 0x8a, 0x26,
+	0x9b, 0xb7, 0x5d, 0x4d, 0xa9, 0x0e, 0x9a, 0xf6, 0xf9, 0xa5, 0xb8, 0xb2, 0x37, 0x60, 0xb8, 0x62,
+	0xe8, 0x4a, 0xd9, 0x32, 0x56, 0xb0, 0x4e, 0xd2, 0x03, 0x93, 0xc2, 0xf4, 0xf0, 0xdc, 0xa1, 0x02,
+	0x53, 0x60, 0xc7, 0xbb, 0xc0, 0xe2, 0x5d, 0x98, 0x37, 0x34, 0xbd, 0x38, 0xf0, 0xf0, 0x69, 0xae,
+	0xaf, 0x04, 0xb6, 0xcc, 0x55, 0x2a, 0x82, 0xae, 0xc3, 0x28, 0x51, 0x56, 0xca, 0x7e, 0x2d, 0x3b,
+	0x3a, 0x69, 0x39, 0x60, 0x6b, 0xd9, 0x7c, 0x9a, 0x1b, 0x59, 0x5a, 0xb8, 0x54, 0x74, 0x55, 0x95,
+	0x46, 0x88, 0xb2, 0xe2, 0x7d, 0xa2, 0xab, 0x30, 0x48, 0x96, 0x65, 0x13, 0x93, 0xf4, 0x20, 0x85,
+	0x76, 0xd6, 0x96, 0x7a, 0xf2, 0x34, 0x77, 0x54, 0xd5, 0xac, 0xe5, 0x66, 0xa5, 0x50, 0x35, 0xea,
+	0x8c, 0x52, 0xec, 0x9f, 0x3c, 0x51, 0x56, 0x24, 0xeb, 0x76, 0x03, 0x93, 0xc2, 0x02, 0xae, 0x3e,
+	0xbe, 0x9f, 0x07, 0xe6, 0xc1, 0x02, 0xae, 0x96, 0x98, 0x2e, 0x74, 0x1a, 0x76, 0x56, 0xe4, 0x9a,
+	0xac, 0x57, 0x71, 0x7a, 0x67, 0x32, 0xb4, 0xfc, 0xfc, 0x99, 0xa1, 0x4f, 0xef, 0xe6, 0xfa, 0xfe,
+	0xbe, 0x9b, 0xeb, 0x13, 0xff, 0x4d, 0xc1, 0x01, 0x3f, 0x59, 0xde, 0xd3, 0x6d, 0xfc, 0x9a, 0xae,
+	0x6e, 0x33, 0xae, 0x1c, 0x83, 0xd1, 0xaa, 0x89, 0x29, 0x8b, 0xcb, 0xcb, 0x58, 0x53, 0x97, 0x2d,
+	0x4a, 0x93, 0x54, 0x69, 0x0f, 0x5f, 0xbe, 0x48, 0x57, 0xd1, 0x65, 0x18, 0xad, 0x1a, 0xf5, 0x46,
+	0x0d, 0xd3, 0xa3, 0xf6, 0xe5, 0x66, 0x5c, 0xc8, 0x14, 0x9c, 0x9b, 0x5f, 0xe0, 0x37, 0xbf, 0x70,
+	0x95, 0xdf, 0xfc, 0xe2, 0x90, 0x1d, 0x9e, 0x3b, 0xcf, 0x72, 0x42, 0x69, 0x8f, 0x27, 0x6c, 0x6f,
+	0x07, 0x69, 0xb5, 0xa3, 0x27, 0xb4, 0x1a, 0xec, 0x0d, 0xad, 0x7a, 0x42, 0x80, 0xef, 0x04, 0xc8,
+	0xbd, 0x6b, 0x17, 0xad, 0x45, 0xff, 0xa5, 0xaa, 0x56, 0x8d, 0xa6, 0x6e, 0x95, 0xf0, 0xad, 0x26,
+	0x26, 0xd6, 0xf6, 0xa2, 0x82, 0x68, 0xc0, 0x64, 0xbc, 0xc3, 0xa4, 0x61, 0xe8, 0x04, 0xc7, 0x96,
+	0x16, 0x61, 0x0b, 0xa5, 0x45, 0x5c, 0x83, 0xe9, 0x38, 0x83, 0x0b, 0x1c, 0x23, 0x0f, 0x55, 0x4f,
+	0x0d, 0x9b, 0xf0, 0xff, 0x04, 0x86, 0x19, 0xe4, 0xde, 0x24, 0x49, 0xbc, 0x09, 0xe3, 0xd4, 0xa6,
+	0xcb, 0xb3, 0xf7, 0xe9, 0xbd, 0x22, 0x1c, 0xe0, 0x79, 0x00, 0xef, 0x55, 0xa3, 0xfa, 0x87, 0xe7,
+	0x8e, 0xb6, 0xf0, 0xce, 0x79, 0x9c, 0x39, 0xfb, 0xde, 0x91, 0x55, 0xcc, 0x64, 0x4b, 0x3e, 0x49,
+	0xf1, 0x67, 0x01, 0x26, 0x62, 0x0c, 0x31, 0x40, 0xd7, 0x01, 0x79, 0x97, 0xa6, 0xbc, 0xe6, 0xec,
+	0xa6, 0x85, 0xc9, 0xd4, 0xf4, 0xf0, 0xdc, 0x91, 0x42, 0xe0, 0xd5, 0x2f, 0x04, 0xd4, 0x2c, 0xea,
+	0x37, 0x0d, 0x46, 0xfa, 0xb1, 0x4a, 0xc0, 0x02, 0xba, 0xd0, 0x82, 0xa1, 0x9f, 0x62, 0x38, 0xd6,
+	0x11, 0x83, 0xe3, 0x56, 0x0b, 0x88, 0x9f, 0x04, 0xd8, 0x17, 0x61, 0x18, 0x4d, 0x00, 0xad, 0x00,
+	0x65, 0x05, 0xeb, 0x46, 0xdd, 0x49, 0x42, 0x69, 0x97, 0xbd, 0xb2, 0x60, 0x2f, 0xa0, 0x65, 0xd8,
+	0x1b, 0x42, 0x96, 0xee, 0xef, 0xc1, 0xd3, 0x30, 0x1a, 0x80, 0x4a, 0x1d, 0x91, 0x09, 0x66, 0x8e,
+	0xa4, 0x98, 0x23, 0x32, 0xc1, 0xd4, 0x11, 0xf1, 0x2c, 0x1c, 0x8e, 0xca, 0x01, 0xcf, 0x75, 0x7b,
+	0x18, 0xe2, 0x13, 0x21, 0x9a, 0x2b, 0x6e, 0x06, 0xaf, 0x45, 0xe1, 0x74, 0x28, 0xd3, 0x4d, 0x02,
+	0x43, 0xa8, 0xb2, 0x00, 0xa4, 0xa9, 0x13, 0x6c, 0x59, 0x9a, 0xae, 0xd2, 0xc0, 0x0d, 0x95, 0x7c,
+	0x2b, 0xa8, 0x08, 0x23, 0x8e, 0xb1, 0x72, 0xc5, 0x68, 0xea, 0x8a, 0xd3, 0x51, 0x0c, 0xcf, 0x4d,
+	0x84, 0x6c, 0x3a, 0xfa, 0x8a, 0xf4, 0x50, 0x69, 0xf7, 0x9a, 0xef, 0x4b, 0x54, 0x21, 0x4b, 0xb1,
+	0x5d, 0xe3, 0xe5, 0xa7, 0xc8, 0x71, 0xfb, 0xaa, 0x62, 0xb8, 0x9c, 0x09, 0x5d, 0x97, 0xb3, 0x32,
+	0xe4, 0x62, 0x0d, 0xb1, 0x38, 0x76, 0xa0, 0x53, 0x6b, 0x92, 0xfb, 0x83, 0x49, 0xfe, 0x2a, 0x05,
+	0xa3, 0xec, 0x75, 0xbf, 0x88, 0x65, 0xc5, 0x34, 0x8c, 0x7a, 0x27, 0x8d, 0x2f, 0xc3, 0x40, 0x55,
+	0x6e, 0x10, 0x76, 0x35, 0xc6, 0x43, 0x71, 0x63, 0xea, 0xe6, 0xe5, 0x06, 0x61, 0x39, 0xa2, 0xe7,
+	0x91, 0x0c, 0x23, 0xb6, 0x12, 0xec, 0xbe, 0x74, 0xa9, 0xae, 0x49, 0xbd, 0xa8, 0x5b, 0x3e, 0x52,
+	0x2f, 0xea, 0x56, 0x69, 0xb7, 0xa3, 0x92, 0x3d, 0x7a, 0x37, 0x60, 0xb8, 0x61, 0xac, 0x61, 0xb3,
+	0x4c, 0xbb, 0xa0, 0xf4, 0x40, 0xd7, 0x06, 0xc2, 0xb7, 0x06, 0xa8, 0xc2, 0x25, 0x5b, 0x1f, 0x3a,
+	0x08, 0x83, 0x55, 0xb9, 0xd1, 0xc0, 0x0a, 0x7d, 0xea, 0x87, 0x4a, 0xec, 0x0b, 0x5d, 0x87, 0xa1,
+	0x65, 0x16, 0xbc, 0xf4, 0x60, 0x0f, 0x40, 0xb9, 0xda, 0x7c, 0x4f, 0x31, 0x2f, 0xbd, 0x81, 0x64,
+	0xf5, 0xbc, 0xf4, 0x7e, 0xcb, 0x4b, 0x6f, 0xd8, 0x10, 0x23, 0xdc, 0x02, 0xec, 0xe2, 0xfe, 0xf1,
+	0x8a, 0x3b, 0x19, 0x47, 0x02, 0x2e, 0xcd, 0x88, 0xe0, 0x09, 0xf6, 0xae, 0xcc, 0xf2, 0x32, 0x15,
+	0xb0, 0x98, 0xb0, 0x4c, 0x55, 0xa2, 0xc3, 0xea, 0x82, 0x2d, 0xfa, 0x52, 0xeb, 0x04, 0x35, 0x29,
+	0x56, 0x57, 0x4e, 0xfc, 0x5e, 0x00, 0x91, 0x1a, 0x89, 0x1e, 0xbc, 0xb6, 0x67, 0x23, 0x65, 0xc1,
+	0x54, 0x5b, 0x9f, 0x59, 0x7c, 0x2e, 0x03, 0x28, 0xee, 0x2a, 0x8b, 0xd0, 0xb1, 0x50, 0x84, 0xa2,
+	0x95, 0xf0, 0x7e, 0xd8, 0x53, 0x60, 0xbf, 0x99, 0x27, 0xa8, 0x59, 0xb7, 0x85, 0x89, 0x16, 0x25,
+	0x3d, 0x0e, 0xda, 0xf9, 0x08, 0x36, 0x6e, 0xe5, 0xf6, 0xfc, 0x2a, 0x40, 0x3e, 0xa1, 0xff, 0x2c,
+	0x80, 0x57, 0x60, 0xd8, 0xc3, 0xcf, 0xef, 0x53, 0x97, 0x11, 0xf4, 0x6b, 0xe8, 0xdd, 0xc5, 0xfa,
+	0x21, 0x8a, 0xb6, 0xee, 0x08, 0xb8, 0x5d, 0x33, 0xf0, 0xa3, 0x00, 0x53, 0x6d, 0xbd, 0x66, 0x71,
+	0x7f, 0x1b, 0xa0, 0xe9, 0xae, 0xb2, 0xb0, 0x1f, 0x6d, 0x1b, 0x76, 0x57, 0x09, 0xe7, 0xad, 0x27,
+	0xdf, 0xbb, 0xa0, 0xe7, 0x58, 0xf5, 0x9d, 0x37, 0xf4, 0x55, 0x6c, 0x12, 0xe7, 0xaa, 0x61, 0x73,
+	0x95, 0x63, 0x15, 0x1f, 0x08, 0x90, 0x8d, 0x3b, 0xc1, 0xa0, 0xcd, 0xc1, 0xce, 0xa4, 0x79, 0xe0,
+	0x07, 0x11, 0xf6, 0xc6, 0xc5, 0xfe, 0xc9, 0x54, 0xfb, 0x71, 0x71, 0xc6, 0x86, 0x7f, 0xef, 0x59,
+	0x6e, 0x3a, 0xc1, 0xe3, 0x66, 0x0b, 0x10, 0x77, 0xb4, 0x9c, 0xfb, 0x67, 0x0c, 0x76, 0x50, 0xef,
+	0xd1, 0x5f, 0x02, 0xec, 0x8b, 0x18, 0x5d, 0xd0, 0x4c, 0x28, 0x07, 0x1d, 0x06, 0xd0, 0xcc, 0x6c,
+	0x17, 0x12, 0x4e, 0x84, 0xc4, 0x95, 0x4f, 0x7e, 0xfb, 0xf3, 0xb3, 0x7e, 0x8c, 0xaa, 0x52, 0xf0,
+	0x6f, 0x66, 0x2e, 0x2f, 0x89, 0xb4, 0x1e, 0xa2, 0xf5, 0x86, 0xe4, 0x96, 0x49, 0x22, 0xad, 0x87,
+	0xaa, 0xec, 0x86, 0xd4, 0x3a, 0xdd, 0x31, 0x44, 0x2f, 0x04, 0x18, 0x6f, 0x37, 0xa4, 0xa1, 0xd3,
+	0x89, 0x01, 0x04, 0x27, 0xca, 0xcc, 0x99, 0xad, 0x88, 0xb2, 0x20, 0x5c, 0xa1, 0x41, 0x58, 0x44,
+	0x17, 0x42, 0x41, 0x88, 0x82, 0x41, 0xa4, 0xf5, 0xa8, 0xd9, 0x75, 0xc3, 0x8b, 0x18, 0xfa, 0x42,
+	0x80, 0xb1, 0xe0, 0xc0, 0x86, 0xf2, 0xd1, 0x1e, 0xc6, 0x4c, 0x90, 0x99, 0x42, 0xd2, 0xe3, 0x0c,
+	0xc4, 0x4b, 0x14, 0xc4, 0xff, 0xd0, 0x54, 0x08, 0x44, 0x78, 0x3c, 0x44, 0xdf, 0x08, 0x30, 0x1a,
+	0xd0, 0x84, 0x4e, 0x24, 0x32, 0xc8, 0xdd, 0xcb, 0x27, 0x3c, 0xcd, 0xbc, 0x7b, 0x95, 0x7a, 0x37,
+	0x87, 0x66, 0x12, 0x78, 0x27, 0xad, 0x7b, 0x7d, 0xca, 0x06, 0x7a, 0x20, 0x00, 0x0a, 0x37, 0xfd,
+	0x48, 0x8a, 0xb6, 0x1f, 0x3b, 0x87, 0x64, 0x66, 0x92, 0x0b, 0x30, 0x9f, 0x8b, 0xd4, 0xe7, 0xb3,
+	0xe8, 0x4c, 0xc8, 0xe7, 0x0e, 0xe4, 0xf7, 0xdc, 0x47, 0x9f, 0x0b, 0x30, 0x16, 0xec, 0x1f, 0xe3,
+	0x98, 0x10, 0xd3, 0xd0, 0x66, 0x0a, 0x49, 0x8f, 0x33, 0xbf, 0x8f, 0x53, 0xbf, 0x8f, 0x20, 0x31,
+	0xe4, 0x37, 0xfb, 0x59, 0xf6, 0x9a, 0xcf, 0xaf, 0x85, 0xf0, 0xd4, 0x73, 0x22, 0x91, 0xbd, 0x0e,
+	0x44, 0x88, 0x69, 0x23, 0xc5, 0x57, 0xa8, 0x73, 0xb3, 0x48, 0xea, 0xec, 0x5c, 0x2b, 0x0f, 0x5e,
+	0x08, 0xb1, 0x7f, 0xaf, 0x3f, 0x19, 0xed, 0x42, 0xdb, 0x26, 0x33, 0x73, 0xaa, 0x3b, 0x21, 0xe6,
+	0xbe, 0x4c, 0xdd, 0xff, 0x08, 0x7d, 0xd0, 0xe3, 0x7a, 0xe9, 0xf5, 0x2d, 0x36, 0xd0, 0xc9, 0x4e,
+	0x4d, 0x13, 0x3a, 0x17, 0xed, 0x7d, 0xc2, 0x66, 0x31, 0xf3, 0xfa, 0x56, 0xc5, 0x59, 0x18, 0xe6,
+	0x69, 0x18, 0xce, 0xa1, 0xd7, 0xba, 0x0d, 0x83, 0xbf, 0x3f, 0xfb, 0x25, 0x90, 0x51, 0xaf, 0x37,
+	0x49, 0x92, 0xd1, 0x50, 0xff, 0x95, 0x39, 0xd5, 0x9d, 0x50, 0xc7, 0x5b, 0xde, 0x01, 0x8a, 0xaf,
+	0xe9, 0xf9, 0x52, 0x80, 0xbd, 0xa1, 0x2e, 0x04, 0xc5, 0xdc, 0xdb, 0xb8, 0x86, 0x26, 0x23, 0x25,
+	0x3e, 0xdf, 0xb1, 0xe4, 0x57, 0x5d, 0x99, 0xb2, 0xe9, 0x08, 0x15, 0x6f, 0x3c, 0xdc, 0xcc, 0x0a,
+	0x8f, 0x36, 0xb3, 0xc2, 0x1f, 0x9b, 0x59, 0xe1, 0xce, 0xf3, 0x6c, 0xdf, 0xa3, 0xe7, 0xd9, 0xbe,
+	0xdf, 0x9f, 0x67, 0xfb, 0x3e, 0x9c, 0xf7, 0x75, 0x2f, 0xba, 0x61, 0xe7, 0x46, 0xae, 0xe5, 0x6b,
+	0x72, 0x85, 0x38, 0x6a, 0xf3, 0x4c, 0x6f, 0xbe, 0x6e, 0x28, 0xcd, 0x1a, 0x96, 0x3e, 0x6e, 0x5d,
+	0x76, 0xda, 0x9b, 0xca, 0x20, 0xfd, 0xff, 0x82, 0x93, 0xff, 0x0d, 0x00, 0x04, 0xec, 0x6d, 0x79,
+	0x85, 0x1c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// IntermediaryAccount queries the intermediary account of a (delegator,
+	// validator) pair.
+	IntermediaryAccount(ctx context.Context, in *QueryIntermediaryAccountRequest, opts ...grpc.CallOption) (*QueryIntermediaryAccountResponse, error)
+	// IntermediaryAccountDelegator queries the delegator an intermediary
+	// account acts for.
+	IntermediaryAccountDelegator(ctx context.Context, in *QueryIntermediaryAccountDelegatorRequest, opts ...grpc.CallOption) (*QueryIntermediaryAccountDelegatorResponse, error)
+	// BondTokenWeights queries the accepted bond denoms and their weights.
+	BondTokenWeights(ctx context.Context, in *QueryBondTokenWeightsRequest, opts ...grpc.CallOption) (*QueryBondTokenWeightsResponse, error)
+	// BondTokenWeight queries the weight of a bond denom.
+	BondTokenWeight(ctx context.Context, in *QueryBondTokenWeightRequest, opts ...grpc.CallOption) (*QueryBondTokenWeightResponse, error)
+	// ValidatorBondDenom queries the bond denom a validator is pinned to.
+	ValidatorBondDenom(ctx context.Context, in *QueryValidatorBondDenomRequest, opts ...grpc.CallOption) (*QueryValidatorBondDenomResponse, error)
+	// StakingHeadrooms queries the staking caps of the bond denoms and the bond
+	// tokens that can still be delegated within them.
+	StakingHeadrooms(ctx context.Context, in *QueryStakingHeadroomsRequest, opts ...grpc.CallOption) (*QueryStakingHeadroomsResponse, error)
+	// StakingHeadroom queries the staking caps of a bond denom and the bond
+	// tokens that can still be delegated within them.
+	StakingHeadroom(ctx context.Context, in *QueryStakingHeadroomRequest, opts ...grpc.CallOption) (*QueryStakingHeadroomResponse, error)
+	// MultiStakingDelegation queries the multi-staking delegation of a
+	// (delegator, validator) pair.
+	MultiStakingDelegation(ctx context.Context, in *QueryMultiStakingDelegationRequest, opts ...grpc.CallOption) (*QueryMultiStakingDelegationResponse, error)
+	// DelegatorMultiStakingDelegations queries all the multi-staking
+	// delegations of a delegator.
+	DelegatorMultiStakingDelegations(ctx context.Context, in *QueryDelegatorMultiStakingDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorMultiStakingDelegationsResponse, error)
+	// MultiStakingUnbondings queries all the multi-staking unbondings of a
+	// delegator.
+	MultiStakingUnbondings(ctx context.Context, in *QueryMultiStakingUnbondingsRequest, opts ...grpc.CallOption) (*QueryMultiStakingUnbondingsResponse, error)
+	// ConversionReserve queries the address and the balance of the conversion
+	// reserve.
+	ConversionReserve(ctx context.Context, in *QueryConversionReserveRequest, opts ...grpc.CallOption) (*QueryConversionReserveResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) IntermediaryAccount(ctx context.Context, in *QueryIntermediaryAccountRequest, opts ...grpc.CallOption) (*QueryIntermediaryAccountResponse, error) {
+	out := new(QueryIntermediaryAccountResponse)
+	err := c.cc.Invoke(ctx, "/multistaking.v1.Query/IntermediaryAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IntermediaryAccountDelegator(ctx context.Context, in *QueryIntermediaryAccountDelegatorRequest, opts ...grpc.CallOption) (*QueryIntermediaryAccountDelegatorResponse, error) {
+	out := new(QueryIntermediaryAccountDelegatorResponse)
+	err := c.cc.Invoke(ctx, "/multistaking.v1.Query/IntermediaryAccountDelegator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BondTokenWeights(ctx context.Context, in *QueryBondTokenWeightsRequest, opts ...grpc.CallOption) (*QueryBondTokenWeightsResponse, error) {
+	out := new(QueryBondTokenWeightsResponse)
+	err := c.cc.Invoke(ctx, "/multistaking.v1.Query/BondTokenWeights", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BondTokenWeight(ctx context.Context, in *QueryBondTokenWeightRequest, opts ...grpc.CallOption) (*QueryBondTokenWeightResponse, error) {
+	out := new(QueryBondTokenWeightResponse)
+	err := c.cc.Invoke(ctx, "/multistaking.v1.Query/BondTokenWeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorBondDenom(ctx context.Context, in *QueryValidatorBondDenomRequest, opts ...grpc.CallOption) (*QueryValidatorBondDenomResponse, error) {
+	out := new(QueryValidatorBondDenomResponse)
+	err := c.cc.Invoke(ctx, "/multistaking.v1.Query/ValidatorBondDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StakingHeadrooms(ctx context.Context, in *QueryStakingHeadroomsRequest, opts ...grpc.CallOption) (*QueryStakingHeadroomsResponse, error) {
+	out := new(QueryStakingHeadroomsResponse)
+	err := c.cc.Invoke(ctx, "/multistaking.v1.Query/StakingHeadrooms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StakingHeadroom(ctx context.Context, in *QueryStakingHeadroomRequest, opts ...grpc.CallOption) (*QueryStakingHeadroomResponse, error) {
+	out := new(QueryStakingHeadroomResponse)
+	err := c.cc.Invoke(ctx, "/multistaking.v1.Query/StakingHeadroom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MultiStakingDelegation(ctx context.Context, in *QueryMultiStakingDelegationRequest, opts ...grpc.CallOption) (*QueryMultiStakingDelegationResponse, error) {
+	out := new(QueryMultiStakingDelegationResponse)
+	err := c.cc.Invoke(ctx, "/multistaking.v1.Query/MultiStakingDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorMultiStakingDelegations(ctx context.Context, in *QueryDelegatorMultiStakingDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorMultiStakingDelegationsResponse, error) {
+	out := new(QueryDelegatorMultiStakingDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/multistaking.v1.Query/DelegatorMultiStakingDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MultiStakingUnbondings(ctx context.Context, in *QueryMultiStakingUnbondingsRequest, opts ...grpc.CallOption) (*QueryMultiStakingUnbondingsResponse, error) {
+	out := new(QueryMultiStakingUnbondingsResponse)
+	err := c.cc.Invoke(ctx, "/multistaking.v1.Query/MultiStakingUnbondings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ConversionReserve(ctx context.Context, in *QueryConversionReserveRequest, opts ...grpc.CallOption) (*QueryConversionReserveResponse, error) {
+	out := new(QueryConversionReserveResponse)
+	err := c.cc.Invoke(ctx, "/multistaking.v1.Query/ConversionReserve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// IntermediaryAccount queries the intermediary account of a (delegator,
+	// validator) pair.
+	IntermediaryAccount(context.Context, *QueryIntermediaryAccountRequest) (*QueryIntermediaryAccountResponse, error)
+	// IntermediaryAccountDelegator queries the delegator an intermediary
+	// account acts for.
+	IntermediaryAccountDelegator(context.Context, *QueryIntermediaryAccountDelegatorRequest) (*QueryIntermediaryAccountDelegatorResponse, error)
+	// BondTokenWeights queries the accepted bond denoms and their weights.
+	BondTokenWeights(context.Context, *QueryBondTokenWeightsRequest) (*QueryBondTokenWeightsResponse, error)
+	// BondTokenWeight queries the weight of a bond denom.
+	BondTokenWeight(context.Context, *QueryBondTokenWeightRequest) (*QueryBondTokenWeightResponse, error)
+	// ValidatorBondDenom queries the bond denom a validator is pinned to.
+	ValidatorBondDenom(context.Context, *QueryValidatorBondDenomRequest) (*QueryValidatorBondDenomResponse, error)
+	// StakingHeadrooms queries the staking caps of the bond denoms and the bond
+	// tokens that can still be delegated within them.
+	StakingHeadrooms(context.Context, *QueryStakingHeadroomsRequest) (*QueryStakingHeadroomsResponse, error)
+	// StakingHeadroom queries the staking caps of a bond denom and the bond
+	// tokens that can still be delegated within them.
+	StakingHeadroom(context.Context, *QueryStakingHeadroomRequest) (*QueryStakingHeadroomResponse, error)
+	// MultiStakingDelegation queries the multi-staking delegation of a
+	// (delegator, validator) pair.
+	MultiStakingDelegation(context.Context, *QueryMultiStakingDelegationRequest) (*QueryMultiStakingDelegationResponse, error)
+	// DelegatorMultiStakingDelegations queries all the multi-staking
+	// delegations of a delegator.
+	DelegatorMultiStakingDelegations(context.Context, *QueryDelegatorMultiStakingDelegationsRequest) (*QueryDelegatorMultiStakingDelegationsResponse, error)
+	// MultiStakingUnbondings queries all the multi-staking unbondings of a
+	// delegator.
+	MultiStakingUnbondings(context.Context, *QueryMultiStakingUnbondingsRequest) (*QueryMultiStakingUnbondingsResponse, error)
+	// ConversionReserve queries the address and the balance of the conversion
+	// reserve.
+	ConversionReserve(context.Context, *QueryConversionReserveRequest) (*QueryConversionReserveResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) IntermediaryAccount(ctx context.Context, req *QueryIntermediaryAccountRequest) (*QueryIntermediaryAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntermediaryAccount not implemented")
+}
+func (*UnimplementedQueryServer) IntermediaryAccountDelegator(ctx context.Context, req *QueryIntermediaryAccountDelegatorRequest) (*QueryIntermediaryAccountDelegatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntermediaryAccountDelegator not implemented")
+}
+func (*UnimplementedQueryServer) BondTokenWeights(ctx context.Context, req *QueryBondTokenWeightsRequest) (*QueryBondTokenWeightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BondTokenWeights not implemented")
+}
+func (*UnimplementedQueryServer) BondTokenWeight(ctx context.Context, req *QueryBondTokenWeightRequest) (*QueryBondTokenWeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BondTokenWeight not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorBondDenom(ctx context.Context, req *QueryValidatorBondDenomRequest) (*QueryValidatorBondDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorBondDenom not implemented")
+}
+func (*UnimplementedQueryServer) StakingHeadrooms(ctx context.Context, req *QueryStakingHeadroomsRequest) (*QueryStakingHeadroomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakingHeadrooms not implemented")
+}
+func (*UnimplementedQueryServer) StakingHeadroom(ctx context.Context, req *QueryStakingHeadroomRequest) (*QueryStakingHeadroomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakingHeadroom not implemented")
+}
+func (*UnimplementedQueryServer) MultiStakingDelegation(ctx context.Context, req *QueryMultiStakingDelegationRequest) (*QueryMultiStakingDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiStakingDelegation not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorMultiStakingDelegations(ctx context.Context, req *QueryDelegatorMultiStakingDelegationsRequest) (*QueryDelegatorMultiStakingDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorMultiStakingDelegations not implemented")
+}
+func (*UnimplementedQueryServer) MultiStakingUnbondings(ctx context.Context, req *QueryMultiStakingUnbondingsRequest) (*QueryMultiStakingUnbondingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiStakingUnbondings not implemented")
+}
+func (*UnimplementedQueryServer) ConversionReserve(ctx context.Context, req *QueryConversionReserveRequest) (*QueryConversionReserveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConversionReserve not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_IntermediaryAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIntermediaryAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IntermediaryAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/multistaking.v1.Query/IntermediaryAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IntermediaryAccount(ctx, req.(*QueryIntermediaryAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IntermediaryAccountDelegator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIntermediaryAccountDelegatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IntermediaryAccountDelegator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/multistaking.v1.Query/IntermediaryAccountDelegator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IntermediaryAccountDelegator(ctx, req.(*QueryIntermediaryAccountDelegatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BondTokenWeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBondTokenWeightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BondTokenWeights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/multistaking.v1.Query/BondTokenWeights",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BondTokenWeights(ctx, req.(*QueryBondTokenWeightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BondTokenWeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBondTokenWeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BondTokenWeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/multistaking.v1.Query/BondTokenWeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BondTokenWeight(ctx, req.(*QueryBondTokenWeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorBondDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorBondDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorBondDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/multistaking.v1.Query/ValidatorBondDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorBondDenom(ctx, req.(*QueryValidatorBondDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StakingHeadrooms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakingHeadroomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StakingHeadrooms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/multistaking.v1.Query/StakingHeadrooms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StakingHeadrooms(ctx, req.(*QueryStakingHeadroomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StakingHeadroom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakingHeadroomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StakingHeadroom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/multistaking.v1.Query/StakingHeadroom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StakingHeadroom(ctx, req.(*QueryStakingHeadroomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MultiStakingDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMultiStakingDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MultiStakingDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/multistaking.v1.Query/MultiStakingDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MultiStakingDelegation(ctx, req.(*QueryMultiStakingDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorMultiStakingDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorMultiStakingDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorMultiStakingDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/multistaking.v1.Query/DelegatorMultiStakingDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorMultiStakingDelegations(ctx, req.(*QueryDelegatorMultiStakingDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MultiStakingUnbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMultiStakingUnbondingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MultiStakingUnbondings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/multistaking.v1.Query/MultiStakingUnbondings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MultiStakingUnbondings(ctx, req.(*QueryMultiStakingUnbondingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ConversionReserve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConversionReserveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConversionReserve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/multistaking.v1.Query/ConversionReserve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConversionReserve(ctx, req.(*QueryConversionReserveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "multistaking.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "IntermediaryAccount",
+			Handler:    _Query_IntermediaryAccount_Handler,
+		},
+		{
+			MethodName: "IntermediaryAccountDelegator",
+			Handler:    _Query_IntermediaryAccountDelegator_Handler,
+		},
+		{
+			MethodName: "BondTokenWeights",
+			Handler:    _Query_BondTokenWeights_Handler,
+		},
+		{
+			MethodName: "BondTokenWeight",
+			Handler:    _Query_BondTokenWeight_Handler,
+		},
+		{
+			MethodName: "ValidatorBondDenom",
+			Handler:    _Query_ValidatorBondDenom_Handler,
+		},
+		{
+			MethodName: "StakingHeadrooms",
+			Handler:    _Query_StakingHeadrooms_Handler,
+		},
+		{
+			MethodName: "StakingHeadroom",
+			Handler:    _Query_StakingHeadroom_Handler,
+		},
+		{
+			MethodName: "MultiStakingDelegation",
+			Handler:    _Query_MultiStakingDelegation_Handler,
+		},
+		{
+			MethodName: "DelegatorMultiStakingDelegations",
+			Handler:    _Query_DelegatorMultiStakingDelegations_Handler,
+		},
+		{
+			MethodName: "MultiStakingUnbondings",
+			Handler:    _Query_MultiStakingUnbondings_Handler,
+		},
+		{
+			MethodName: "ConversionReserve",
+			Handler:    _Query_ConversionReserve_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "multistaking/v1/query.proto",
+}
+
+func (m *MultiStakingDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiStakingDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiStakingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.SDKBondTokens.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.BondTokens.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.IntermediaryAddress) > 0 {
+		i -= len(m.IntermediaryAddress)
+		copy(dAtA[i:], m.IntermediaryAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IntermediaryAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultiStakingUnbonding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiStakingUnbonding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiStakingUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.SDKBondTokens.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.BondTokens.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintQuery(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x22
+	if m.CreationHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntermediaryAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntermediaryAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntermediaryAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntermediaryAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntermediaryAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntermediaryAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IntermediaryAddress) > 0 {
+		i -= len(m.IntermediaryAddress)
+		copy(dAtA[i:], m.IntermediaryAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IntermediaryAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntermediaryAccountDelegatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntermediaryAccountDelegatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntermediaryAccountDelegatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IntermediaryAddress) > 0 {
+		i -= len(m.IntermediaryAddress)
+		copy(dAtA[i:], m.IntermediaryAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IntermediaryAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntermediaryAccountDelegatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntermediaryAccountDelegatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntermediaryAccountDelegatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBondTokenWeightsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBondTokenWeightsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBondTokenWeightsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBondTokenWeightsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBondTokenWeightsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBondTokenWeightsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BondTokenWeights) > 0 {
+		for iNdEx := len(m.BondTokenWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BondTokenWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BondTokenWeightInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BondTokenWeightInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BondTokenWeightInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.BondTokenWeight.Size()
+		i -= size
+		if _, err := m.BondTokenWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.BondDenom) > 0 {
+		i -= len(m.BondDenom)
+		copy(dAtA[i:], m.BondDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BondDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBondTokenWeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBondTokenWeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBondTokenWeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BondDenom) > 0 {
+		i -= len(m.BondDenom)
+		copy(dAtA[i:], m.BondDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BondDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBondTokenWeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBondTokenWeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBondTokenWeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WeightBounds != nil {
+		{
+			size, err := m.WeightBounds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sunsetting {
+		i--
+		if m.Sunsetting {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.BondTokenWeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorBondDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorBondDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorBondDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorBondDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorBondDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorBondDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BondDenom) > 0 {
+		i -= len(m.BondDenom)
+		copy(dAtA[i:], m.BondDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BondDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StakingHeadroom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakingHeadroom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakingHeadroom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Headroom.Size()
+		i -= size
+		if _, err := m.Headroom.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Capped {
+		i--
+		if m.Capped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.PowerShare.Size()
+		i -= size
+		if _, err := m.PowerShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.BondedTokens.Size()
+		i -= size
+		if _, err := m.BondedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Caps.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.BondDenom) > 0 {
+		i -= len(m.BondDenom)
+		copy(dAtA[i:], m.BondDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BondDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakingHeadroomsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingHeadroomsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingHeadroomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakingHeadroomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingHeadroomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingHeadroomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Headrooms) > 0 {
+		for iNdEx := len(m.Headrooms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headrooms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakingHeadroomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingHeadroomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingHeadroomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BondDenom) > 0 {
+		i -= len(m.BondDenom)
+		copy(dAtA[i:], m.BondDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BondDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakingHeadroomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingHeadroomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingHeadroomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Headroom.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMultiStakingDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMultiStakingDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMultiStakingDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMultiStakingDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMultiStakingDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMultiStakingDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorMultiStakingDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorMultiStakingDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorMultiStakingDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorMultiStakingDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorMultiStakingDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorMultiStakingDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMultiStakingUnbondingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMultiStakingUnbondingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMultiStakingUnbondingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMultiStakingUnbondingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMultiStakingUnbondingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMultiStakingUnbondingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Unbondings) > 0 {
+		for iNdEx := len(m.Unbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConversionReserveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConversionReserveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConversionReserveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConversionReserveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConversionReserveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConversionReserveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MultiStakingDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.IntermediaryAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.BondTokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SDKBondTokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Shares.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *MultiStakingUnbonding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CreationHeight != 0 {
+		n += 1 + sovQuery(uint64(m.CreationHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BondTokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SDKBondTokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIntermediaryAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIntermediaryAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IntermediaryAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIntermediaryAccountDelegatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IntermediaryAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIntermediaryAccountDelegatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBondTokenWeightsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBondTokenWeightsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BondTokenWeights) > 0 {
+		for _, e := range m.BondTokenWeights {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BondTokenWeightInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BondDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.BondTokenWeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBondTokenWeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BondDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBondTokenWeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BondTokenWeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Sunsetting {
+		n += 2
+	}
+	if m.WeightBounds != nil {
+		l = m.WeightBounds.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorBondDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorBondDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BondDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *StakingHeadroom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BondDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Caps.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BondedTokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PowerShare.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Capped {
+		n += 2
+	}
+	l = m.Headroom.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStakingHeadroomsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakingHeadroomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Headrooms) > 0 {
+		for _, e := range m.Headrooms {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakingHeadroomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BondDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakingHeadroomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Headroom.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMultiStakingDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMultiStakingDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Delegation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDelegatorMultiStakingDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorMultiStakingDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMultiStakingUnbondingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMultiStakingUnbondingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Unbondings) > 0 {
+		for _, e := range m.Unbondings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConversionReserveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryConversionReserveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MultiStakingDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiStakingDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiStakingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntermediaryAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IntermediaryAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SDKBondTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SDKBondTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiStakingUnbonding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiStakingUnbonding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiStakingUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SDKBondTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SDKBondTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIntermediaryAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIntermediaryAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIntermediaryAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIntermediaryAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIntermediaryAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIntermediaryAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntermediaryAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IntermediaryAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIntermediaryAccountDelegatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIntermediaryAccountDelegatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIntermediaryAccountDelegatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntermediaryAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IntermediaryAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIntermediaryAccountDelegatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIntermediaryAccountDelegatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIntermediaryAccountDelegatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBondTokenWeightsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBondTokenWeightsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBondTokenWeightsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBondTokenWeightsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBondTokenWeightsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBondTokenWeightsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondTokenWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondTokenWeights = append(m.BondTokenWeights, BondTokenWeightInfo{})
+			if err := m.BondTokenWeights[len(m.BondTokenWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BondTokenWeightInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BondTokenWeightInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BondTokenWeightInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondTokenWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondTokenWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBondTokenWeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBondTokenWeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBondTokenWeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBondTokenWeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBondTokenWeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBondTokenWeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondTokenWeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondTokenWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sunsetting", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Sunsetting = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightBounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WeightBounds == nil {
+				m.WeightBounds = &WeightBounds{}
+			}
+			if err := m.WeightBounds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorBondDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorBondDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorBondDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorBondDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorBondDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorBondDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakingHeadroom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakingHeadroom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakingHeadroom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Caps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PowerShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Capped = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headroom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Headroom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakingHeadroomsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakingHeadroomsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakingHeadroomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakingHeadroomsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakingHeadroomsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakingHeadroomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headrooms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headrooms = append(m.Headrooms, StakingHeadroom{})
+			if err := m.Headrooms[len(m.Headrooms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakingHeadroomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakingHeadroomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakingHeadroomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakingHeadroomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakingHeadroomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakingHeadroomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headroom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Headroom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMultiStakingDelegationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMultiStakingDelegationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMultiStakingDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMultiStakingDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMultiStakingDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMultiStakingDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorMultiStakingDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorMultiStakingDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorMultiStakingDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorMultiStakingDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorMultiStakingDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorMultiStakingDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, MultiStakingDelegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMultiStakingUnbondingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMultiStakingUnbondingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMultiStakingUnbondingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMultiStakingUnbondingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMultiStakingUnbondingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMultiStakingUnbondingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unbondings = append(m.Unbondings, MultiStakingUnbonding{})
+			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConversionReserveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConversionReserveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConversionReserveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConversionReserveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConversionReserveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConversionReserveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
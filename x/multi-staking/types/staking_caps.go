@@ -0,0 +1,28 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewStakingCaps creates new staking caps. A zero cap is not enforced.
+func NewStakingCaps(maxBondedTokens sdk.Int, maxPowerShare sdk.Dec) StakingCaps {
+	return StakingCaps{MaxBondedTokens: maxBondedTokens, MaxPowerShare: maxPowerShare}
+}
+
+// IsCapped returns whether any of the staking caps is enforced
+func (c StakingCaps) IsCapped() bool {
+	return c.MaxBondedTokens.IsPositive() || c.MaxPowerShare.IsPositive()
+}
+
+// Validate checks that the max bonded tokens are not negative and that the
+// max power share is within [0, 1)
+func (c StakingCaps) Validate() error {
+	if c.MaxBondedTokens.IsNil() || c.MaxBondedTokens.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidStakingCaps, "max bonded tokens must not be negative: %s", c.MaxBondedTokens)
+	}
+	if c.MaxPowerShare.IsNil() || c.MaxPowerShare.IsNegative() || c.MaxPowerShare.GTE(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidStakingCaps, "max power share must be in [0, 1): %s", c.MaxPowerShare)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewWeightBounds creates new weight bounds. A zero max change rate is not
+// enforced.
+func NewWeightBounds(minWeight, maxWeight, maxChangeRate sdk.Dec) WeightBounds {
+	return WeightBounds{MinWeight: minWeight, MaxWeight: maxWeight, MaxChangeRate: maxChangeRate}
+}
+
+// IsZero returns whether all the bounds are zero, which a set weight bounds
+// proposal uses to make the weight of a bond denom static again
+func (b WeightBounds) IsZero() bool {
+	return b.MinWeight.IsZero() && b.MaxWeight.IsZero() && b.MaxChangeRate.IsZero()
+}
+
+// Validate checks that the min weight is positive, that the max weight is not
+// below it and that the max change rate is not negative
+func (b WeightBounds) Validate() error {
+	if b.MinWeight.IsNil() || !b.MinWeight.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidWeightBounds, "min weight must be positive: %s", b.MinWeight)
+	}
+	if b.MaxWeight.IsNil() || b.MaxWeight.LT(b.MinWeight) {
+		return sdkerrors.Wrapf(ErrInvalidWeightBounds, "max weight %s must not be below the min weight %s", b.MaxWeight, b.MinWeight)
+	}
+	if b.MaxChangeRate.IsNil() || b.MaxChangeRate.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidWeightBounds, "max change rate must not be negative: %s", b.MaxChangeRate)
+	}
+	return nil
+}
+
+// Bound returns the target weight moved at most the max change rate away from
+// the current weight, then kept within the min and max weights
+func (b WeightBounds) Bound(weight, target sdk.Dec) sdk.Dec {
+	if b.MaxChangeRate.IsPositive() {
+		maxChange := weight.Mul(b.MaxChangeRate)
+		target = sdk.MinDec(target, weight.Add(maxChange))
+		target = sdk.MaxDec(target, weight.Sub(maxChange))
+	}
+	return sdk.MinDec(sdk.MaxDec(target, b.MinWeight), b.MaxWeight)
+}